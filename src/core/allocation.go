@@ -0,0 +1,121 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// Weighting gives the weight of a part of an allocated period.
+type Weighting func(part Period) float64
+
+// ByDuration weights parts by their duration.
+func ByDuration(part Period) float64 {
+	return float64(part.Duration())
+}
+
+// ByDays weights parts by their number of calendar days, in the location of their start.
+func ByDays(part Period) float64 {
+	return float64(calendarDays(part, part.Start.Location()))
+}
+
+// Allocate splits amount, in minor units such as cents, into parts proportional to weights.
+// Parts are rounded down and the remaining units go to the parts with the largest remainders,
+// the first ones on ties, so that parts always sum to amount.
+func Allocate(amount int64, weights []float64) ([]int64, error) {
+	total := new(big.Rat)
+	rats := make([]*big.Rat, len(weights))
+	for i, w := range weights {
+		if math.IsNaN(w) || math.IsInf(w, 0) || w < 0 {
+			return nil, fmt.Errorf("invalid weight %v", w)
+		}
+		rats[i] = new(big.Rat).SetFloat64(w)
+		total.Add(total, rats[i])
+	}
+	if total.Sign() == 0 {
+		return nil, errors.New("weights must not all be zero")
+	}
+
+	// allocate the absolute amount, so that rounding down moves towards zero
+	sign := int64(1)
+	if amount < 0 {
+		sign = -1
+	}
+	absolute := new(big.Int).Abs(big.NewInt(amount))
+	remaining := new(big.Int).Set(absolute)
+
+	parts := make([]int64, len(weights))
+	remainders := make([]*big.Rat, len(weights))
+	for i, w := range rats {
+		share := new(big.Rat).Mul(new(big.Rat).SetInt(absolute), w)
+		share.Quo(share, total)
+
+		floor := new(big.Int).Quo(share.Num(), share.Denom())
+		parts[i] = floor.Int64()
+		remainders[i] = share.Sub(share, new(big.Rat).SetInt(floor))
+		remaining.Sub(remaining, floor)
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return remainders[b].Cmp(remainders[a])
+	})
+	for _, i := range order[:remaining.Int64()] {
+		parts[i]++
+	}
+
+	for i := range parts {
+		parts[i] *= sign
+	}
+	return parts, nil
+}
+
+// AllocatePeriod splits amount, in minor units such as cents, over the parts of p given by splitter,
+// proportionally to weighting. The parts of the returned Timeline sum to amount exactly.
+func AllocatePeriod(p Period, amount int64, splitter Splitter, weighting Weighting) (Timeline[int64], error) {
+	if !p.IsBounded() {
+		return Timeline[int64]{}, errors.New("cannot allocate over an unbounded period")
+	}
+
+	var periods []Period
+	var weights []float64
+	for part := range splitter(&p) {
+		periods = append(periods, part)
+		weights = append(weights, weighting(part))
+	}
+
+	parts, err := Allocate(amount, weights)
+	if err != nil {
+		return Timeline[int64]{}, err
+	}
+
+	items := make([]PeriodValue[int64], len(parts))
+	for i, part := range parts {
+		items[i] = NewPeriodValue(periods[i], part)
+	}
+	return Timeline[int64]{Items: items}, nil
+}
+
+// AllocateAmount splits amount over the parts of p like AllocatePeriod, rounding parts to given number
+// of decimals, such as 2 for cents. The parts of the returned Timeline sum to amount rounded to the same precision.
+func AllocateAmount(p Period, amount float64, decimals int, splitter Splitter, weighting Weighting) (Timeline[float64], error) {
+	scale := math.Pow10(decimals)
+	minor := math.Round(amount * scale)
+	if math.Abs(minor) >= 1<<63 {
+		return Timeline[float64]{}, fmt.Errorf("amount %v is too large", amount)
+	}
+
+	allocated, err := AllocatePeriod(p, int64(minor), splitter, weighting)
+	if err != nil {
+		return Timeline[float64]{}, err
+	}
+
+	return Map(&allocated, func(part int64) float64 {
+		return float64(part) / scale
+	}), nil
+}
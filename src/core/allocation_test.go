@@ -0,0 +1,77 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		weights  []float64
+		expected []int64
+	}{
+		{"equal thirds", 100000, []float64{1, 1, 1}, []int64{33334, 33333, 33333}},
+		{"largest remainders", 100, []float64{31, 29, 31}, []int64{34, 32, 34}},
+		{"negative amount", -100, []float64{1, 1, 1}, []int64{-34, -33, -33}},
+		{"zero weight", 10, []float64{0, 1, 3}, []int64{0, 3, 7}},
+		{"more parts than units", 2, []float64{1, 1, 1, 1}, []int64{1, 1, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := Allocate(tt.amount, tt.weights)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !slices.Equal(parts, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, parts)
+			}
+		})
+	}
+
+	for _, weights := range [][]float64{{}, {0, 0}, {1, -1}} {
+		if _, err := Allocate(100, weights); err == nil {
+			t.Errorf("Expected an error for weights %v", weights)
+		}
+	}
+}
+
+func TestAllocatePeriod_ShouldMatchTotal(t *testing.T) {
+	quarter, _ := Quarter(2024, 1)
+
+	byDays, err := AllocatePeriod(*quarter, 100000, (*Period).SplitByMonths, ByDays)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 1000.00 over 31, 29 and 31 days of 91
+	if len(byDays.Items) != 3 || byDays.Items[0].Value != 34066 || byDays.Items[1].Value != 31868 || byDays.Items[2].Value != 34066 {
+		t.Errorf("Expected [34066 31868 34066], got %v", byDays.Items)
+	}
+
+	year, _ := Year(2024)
+	daily, _ := AllocatePeriod(*year, 1000001, (*Period).SplitByDays, ByDuration)
+	total := Reduce(&daily, int64(0), func(acc int64, item PeriodValue[int64]) int64 { return acc + item.Value })
+	if len(daily.Items) != 366 || total != 1000001 {
+		t.Errorf("Expected 366 days summing to 1000001, got %d days summing to %d", len(daily.Items), total)
+	}
+}
+
+func TestAllocateAmount(t *testing.T) {
+	quarter, _ := Quarter(2024, 1)
+
+	monthly, err := AllocateAmount(*quarter, 1000, 2, (*Period).SplitByMonths, func(part Period) float64 { return 1 })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if monthly.Items[0].Value != 333.34 || monthly.Items[1].Value != 333.33 || monthly.Items[2].Value != 333.33 {
+		t.Errorf("Expected [333.34 333.33 333.33], got %v", monthly.Items)
+	}
+
+	if _, err := AllocateAmount(Since(quarter.Start), 1000, 2, (*Period).SplitByMonths, ByDays); err == nil {
+		t.Errorf("Expected an error for an unbounded period")
+	}
+}
@@ -0,0 +1,294 @@
+package core
+
+import (
+	"errors"
+	"iter"
+	"sync"
+	"time"
+)
+
+// HolidayRule gives the holidays of a year.
+type HolidayRule interface {
+	// Holidays returns the dates of the holidays in given year, at midnight in given location.
+	Holidays(year int, loc *time.Location) []time.Time
+}
+
+// FixedHoliday is a holiday falling on the same day every year, such as the 1st of May.
+type FixedHoliday struct {
+	Month time.Month
+	Day   int
+}
+
+// Holidays returns the fixed date in given year.
+func (h FixedHoliday) Holidays(year int, loc *time.Location) []time.Time {
+	return []time.Time{time.Date(year, h.Month, h.Day, 0, 0, 0, 0, loc)}
+}
+
+// EasterHoliday is a movable holiday, falling a given number of days after Easter sunday.
+type EasterHoliday struct {
+	Offset int
+}
+
+// Holidays returns the movable date in given year.
+func (h EasterHoliday) Holidays(year int, loc *time.Location) []time.Time {
+	return []time.Time{EasterSunday(year, loc).AddDate(0, 0, h.Offset)}
+}
+
+// EasterSunday returns the date of western Easter sunday for given year, using the anonymous gregorian algorithm.
+func EasterSunday(year int, loc *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// FrenchHolidays returns the rules of the 11 french public holidays.
+func FrenchHolidays() []HolidayRule {
+	return []HolidayRule{
+		FixedHoliday{Month: time.January, Day: 1},   // Jour de l'an
+		EasterHoliday{Offset: 1},                    // Lundi de Pâques
+		FixedHoliday{Month: time.May, Day: 1},       // Fête du travail
+		FixedHoliday{Month: time.May, Day: 8},       // Victoire 1945
+		EasterHoliday{Offset: 39},                   // Ascension
+		EasterHoliday{Offset: 50},                   // Lundi de Pentecôte
+		FixedHoliday{Month: time.July, Day: 14},     // Fête nationale
+		FixedHoliday{Month: time.August, Day: 15},   // Assomption
+		FixedHoliday{Month: time.November, Day: 1},  // Toussaint
+		FixedHoliday{Month: time.November, Day: 11}, // Armistice
+		FixedHoliday{Month: time.December, Day: 25}, // Noël
+	}
+}
+
+// BusinessCalendar tells which days are worked, given weekend days, holiday rules and custom closures.
+// Days are considered at midnight in the calendar location.
+// Once configured, a BusinessCalendar is safe for concurrent use: holidays are cached per year under a lock.
+type BusinessCalendar struct {
+	loc      *time.Location
+	weekend  map[time.Weekday]bool
+	rules    []HolidayRule
+	closures []Period
+
+	mu       sync.Mutex
+	holidays map[int]map[time.Time]struct{}
+}
+
+// NewBusinessCalendar creates a BusinessCalendar with saturday and sunday as weekend and no holidays.
+func NewBusinessCalendar(loc *time.Location) *BusinessCalendar {
+	return &BusinessCalendar{
+		loc:      loc,
+		weekend:  map[time.Weekday]bool{time.Saturday: true, time.Sunday: true},
+		holidays: map[int]map[time.Time]struct{}{},
+	}
+}
+
+// NewFrenchBusinessCalendar creates a BusinessCalendar for France, in Europe/Paris location.
+func NewFrenchBusinessCalendar() (*BusinessCalendar, error) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		return nil, err
+	}
+	return NewBusinessCalendar(paris).AddHolidays(FrenchHolidays()...), nil
+}
+
+// SetWeekend replaces the weekend days.
+func (c *BusinessCalendar) SetWeekend(days ...time.Weekday) *BusinessCalendar {
+	c.weekend = map[time.Weekday]bool{}
+	for _, d := range days {
+		c.weekend[d] = true
+	}
+	return c
+}
+
+// AddHolidays adds holiday rules.
+func (c *BusinessCalendar) AddHolidays(rules ...HolidayRule) *BusinessCalendar {
+	c.rules = append(c.rules, rules...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.holidays = map[int]map[time.Time]struct{}{}
+	return c
+}
+
+// AddClosure adds a custom closure: every day intersecting given period is not worked.
+func (c *BusinessCalendar) AddClosure(period Period) *BusinessCalendar {
+	c.closures = append(c.closures, period)
+	return c
+}
+
+// IsHoliday checks if the day containing t is a holiday.
+func (c *BusinessCalendar) IsHoliday(t time.Time) bool {
+	day := c.midnight(t)
+
+	_, found := c.holidaysOf(day.Year())[day.UTC()]
+	return found
+}
+
+// holidaysOf returns the holidays of given year, computing them on first use.
+func (c *BusinessCalendar) holidaysOf(year int) map[time.Time]struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	holidays, ok := c.holidays[year]
+	if !ok {
+		holidays = map[time.Time]struct{}{}
+		for _, rule := range c.rules {
+			for _, h := range rule.Holidays(year, c.loc) {
+				holidays[h.UTC()] = struct{}{}
+			}
+		}
+		c.holidays[year] = holidays
+	}
+	return holidays
+}
+
+// IsBusinessDay checks if the day containing t is neither a weekend day, a holiday nor closed.
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	day := c.midnight(t)
+
+	if c.weekend[day.Weekday()] || c.IsHoliday(day) {
+		return false
+	}
+
+	whole := Period{Start: day, End: day.AddDate(0, 0, 1)}
+	for _, closure := range c.closures {
+		if closure.Intersects(whole) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BusinessDays returns the business days in given period, as day periods cut at local midnights.
+// The first and last days are cut at the period bounds. The period must be bounded.
+func (c *BusinessCalendar) BusinessDays(p Period) (iter.Seq[Period], error) {
+	if !p.IsBounded() {
+		return nil, errors.New("cannot list business days of an unbounded period")
+	}
+
+	return func(yield func(Period) bool) {
+		for day := range p.SplitByDaysIn(c.loc) {
+			if c.IsBusinessDay(day.Start) && !yield(day) {
+				return
+			}
+		}
+	}, nil
+}
+
+// CountBusinessDays returns the number of business days starting in given period, which must be bounded.
+func (c *BusinessCalendar) CountBusinessDays(p Period) (int, error) {
+	days, err := c.BusinessDays(p)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for day := range days {
+		if day.Start.Equal(c.midnight(day.Start)) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// BusinessDayFraction returns the share of business days of whole falling in part,
+// to prorate costs per working day rather than per calendar day. Whole must be bounded.
+func (c *BusinessCalendar) BusinessDayFraction(part Period, whole Period) (float64, error) {
+	total, err := c.CountBusinessDays(whole)
+	if err != nil {
+		return 0, err
+	}
+	if total == 0 {
+		return 0, errors.New("no business day in period")
+	}
+
+	clamped, err := part.Clamp(whole)
+	if err != nil {
+		// part is outside whole
+		return 0, nil
+	}
+
+	count, err := c.CountBusinessDays(clamped)
+	if err != nil {
+		return 0, err
+	}
+	return float64(count) / float64(total), nil
+}
+
+// NextBusinessDay returns the midnight of the first business day strictly after the day containing t.
+func (c *BusinessCalendar) NextBusinessDay(t time.Time) time.Time {
+	return c.move(c.midnight(t).AddDate(0, 0, 1), 1)
+}
+
+// PreviousBusinessDay returns the midnight of the last business day strictly before the day containing t.
+func (c *BusinessCalendar) PreviousBusinessDay(t time.Time) time.Time {
+	return c.move(c.midnight(t).AddDate(0, 0, -1), -1)
+}
+
+// BusinessDayConvention tells how to adjust a date not falling on a business day.
+type BusinessDayConvention int
+
+const (
+	// AdjustFollowing moves to the next business day.
+	AdjustFollowing BusinessDayConvention = iota
+	// AdjustModifiedFollowing moves to the next business day, unless it is in the next month: then moves to the previous one.
+	AdjustModifiedFollowing
+	// AdjustPreceding moves to the previous business day.
+	AdjustPreceding
+	// AdjustModifiedPreceding moves to the previous business day, unless it is in the previous month: then moves to the next one.
+	AdjustModifiedPreceding
+)
+
+// Adjust moves t off weekends, holidays and closures following given convention.
+// The time of day of t is kept; business days are returned unchanged.
+func (c *BusinessCalendar) Adjust(t time.Time, convention BusinessDayConvention) time.Time {
+	if c.IsBusinessDay(t) {
+		return t
+	}
+
+	day := c.midnight(t)
+	var adjusted time.Time
+
+	switch convention {
+	case AdjustFollowing, AdjustModifiedFollowing:
+		adjusted = c.NextBusinessDay(day)
+		if convention == AdjustModifiedFollowing && adjusted.Month() != day.Month() {
+			adjusted = c.PreviousBusinessDay(day)
+		}
+	default:
+		adjusted = c.PreviousBusinessDay(day)
+		if convention == AdjustModifiedPreceding && adjusted.Month() != day.Month() {
+			adjusted = c.NextBusinessDay(day)
+		}
+	}
+
+	local := t.In(c.loc)
+	return time.Date(adjusted.Year(), adjusted.Month(), adjusted.Day(), local.Hour(), local.Minute(), local.Second(), local.Nanosecond(), c.loc)
+}
+
+// move returns the first business day from day, moving by step days.
+// It gives up after ten years, when no day is ever worked.
+func (c *BusinessCalendar) move(day time.Time, step int) time.Time {
+	for i := 0; i < 3660 && !c.IsBusinessDay(day); i++ {
+		day = day.AddDate(0, 0, step)
+	}
+	return day
+}
+
+// midnight returns the local midnight of the day containing t.
+func (c *BusinessCalendar) midnight(t time.Time) time.Time {
+	y, m, d := t.In(c.loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, c.loc)
+}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEasterSunday(t *testing.T) {
+	expected := map[int]time.Time{
+		2019: DateOnly(2019, 4, 21),
+		2024: DateOnly(2024, 3, 31),
+		2025: DateOnly(2025, 4, 20),
+		2038: DateOnly(2038, 4, 25),
+	}
+
+	for year, date := range expected {
+		if easter := EasterSunday(year, time.UTC); !easter.Equal(date) {
+			t.Errorf("Expected Easter %d to be %v, got %v", year, date, easter)
+		}
+	}
+}
+
+func TestBusinessCalendar_FrenchHolidays(t *testing.T) {
+	cal := NewBusinessCalendar(time.UTC).AddHolidays(FrenchHolidays()...)
+
+	holidays := []time.Time{
+		DateOnly(2024, 1, 1),
+		DateOnly(2024, 4, 1),  // Lundi de Pâques
+		DateOnly(2024, 5, 9),  // Ascension
+		DateOnly(2024, 5, 20), // Lundi de Pentecôte
+		DateOnly(2024, 7, 14),
+		DateOnly(2024, 12, 25),
+	}
+	for _, h := range holidays {
+		if !cal.IsHoliday(h) || cal.IsBusinessDay(h) {
+			t.Errorf("Expected %v to be a holiday", h.Format(dateLayout))
+		}
+	}
+
+	if cal.IsHoliday(DateOnly(2024, 5, 10)) {
+		t.Errorf("Expected 2024-05-10 not to be a holiday")
+	}
+
+	if !cal.IsBusinessDay(time.Date(2024, 5, 10, 15, 30, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2024-05-10 to be a business day")
+	}
+}
+
+func TestBusinessCalendar_ShouldBeSafeForConcurrentUse(t *testing.T) {
+	cal := NewBusinessCalendar(time.UTC).AddHolidays(FrenchHolidays()...)
+
+	var wg sync.WaitGroup
+	for year := 2020; year < 2030; year++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !cal.IsHoliday(DateOnly(year, 7, 14)) {
+				t.Errorf("Expected the 14th of July %d to be a holiday", year)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestBusinessCalendar_CountBusinessDays(t *testing.T) {
+	cal := NewBusinessCalendar(time.UTC).AddHolidays(FrenchHolidays()...)
+
+	may, _ := Month(2024, 5)
+	// 23 weekdays, minus 1st, 8th, 9th and 20th of May
+	if count, _ := cal.CountBusinessDays(*may); count != 19 {
+		t.Errorf("Expected 19 business days in May 2024, got %d", count)
+	}
+
+	cal.AddClosure(Period{Start: DateOnly(2024, 5, 27), End: DateOnly(2024, 6, 1)})
+	if count, _ := cal.CountBusinessDays(*may); count != 14 {
+		t.Errorf("Expected 14 business days with closure, got %d", count)
+	}
+
+	cal.SetWeekend(time.Sunday)
+	if count, _ := cal.CountBusinessDays(*may); count != 18 {
+		t.Errorf("Expected 18 business days with sunday only weekend, got %d", count)
+	}
+}
+
+func TestBusinessCalendar_Adjust(t *testing.T) {
+	cal := NewBusinessCalendar(time.UTC).AddHolidays(FrenchHolidays()...)
+
+	tests := []struct {
+		name       string
+		date       time.Time
+		convention BusinessDayConvention
+		expected   time.Time
+	}{
+		{"business day is unchanged", DateOnly(2024, 3, 15), AdjustFollowing, DateOnly(2024, 3, 15)},
+		{"saturday following", DateOnly(2024, 3, 16), AdjustFollowing, DateOnly(2024, 3, 18)},
+		{"saturday preceding", DateOnly(2024, 3, 16), AdjustPreceding, DateOnly(2024, 3, 15)},
+		{"easter sunday following skips easter monday", DateOnly(2024, 3, 31), AdjustFollowing, DateOnly(2024, 4, 2)},
+		{"end of month modified following", DateOnly(2024, 8, 31), AdjustModifiedFollowing, DateOnly(2024, 8, 30)},
+		{"start of month modified preceding", DateOnly(2024, 6, 1), AdjustModifiedPreceding, DateOnly(2024, 6, 3)},
+		{"time of day is kept", time.Date(2024, 3, 16, 10, 0, 0, 0, time.UTC), AdjustFollowing, time.Date(2024, 3, 18, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if adjusted := cal.Adjust(tt.date, tt.convention); !adjusted.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, adjusted)
+			}
+		})
+	}
+
+	if previous := cal.PreviousBusinessDay(DateOnly(2024, 4, 2)); !previous.Equal(DateOnly(2024, 3, 29)) {
+		t.Errorf("Expected previous business day to be 2024-03-29, got %v", previous)
+	}
+}
+
+func TestBusinessCalendar_BusinessDayFraction(t *testing.T) {
+	cal := NewBusinessCalendar(time.UTC)
+
+	february, _ := Month(2024, 2)
+	firstWeek, _ := NewPeriod(DateOnly(2024, 2, 1), DateOnly(2024, 2, 8))
+
+	fraction, err := cal.BusinessDayFraction(*firstWeek, *february)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if fraction != 5.0/21.0 {
+		t.Errorf("Expected 5/21, got %v", fraction)
+	}
+
+	if _, err := cal.BusinessDayFraction(*firstWeek, Since(DateOnly(2024, 2, 1))); err == nil {
+		t.Errorf("Expected an error for an unbounded period")
+	}
+	if _, err := cal.CountBusinessDays(Until(DateOnly(2024, 2, 1))); err == nil {
+		t.Errorf("Expected an error for an unbounded period")
+	}
+}
+
+func TestBusinessCalendar_ShouldUseLocalDays(t *testing.T) {
+	cal, err := NewFrenchBusinessCalendar()
+	if err != nil {
+		t.Skipf("Europe/Paris location unavailable: %v", err)
+	}
+
+	// 23:30 UTC on friday 13 is 01:30 on saturday 14 in Paris
+	if cal.IsBusinessDay(time.Date(2024, 7, 12, 23, 30, 0, 0, time.UTC)) {
+		t.Errorf("Expected saturday in Paris not to be a business day")
+	}
+}
@@ -0,0 +1,249 @@
+package core
+
+import (
+	"fmt"
+	"iter"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Calendar divides time into fiscal years, each made of 4 quarters and 12 fiscal periods.
+// Fiscal years are labelled by the calendar year they start in.
+type Calendar interface {
+	// FiscalYear returns the fiscal year labelled year.
+	FiscalYear(year int) (*Period, error)
+
+	// FiscalQuarter returns the quarter (1 to 4) of the fiscal year labelled year.
+	FiscalQuarter(year int, quarter int) (*Period, error)
+
+	// FiscalPeriod returns the period (1 to 12) of the fiscal year labelled year.
+	FiscalPeriod(year int, period int) (*Period, error)
+
+	// YearOf returns the label of the fiscal year containing t.
+	YearOf(t time.Time) int
+}
+
+// CalendarUnit is a division of a Calendar used to split periods.
+type CalendarUnit int
+
+const (
+	FiscalYears CalendarUnit = iota
+	FiscalQuarters
+	FiscalPeriods
+)
+
+// SplitByCalendar returns periods for each fiscal unit in given period, cut on the boundaries of given calendar.
+// The period must be bounded.
+func (p *Period) SplitByCalendar(cal Calendar, unit CalendarUnit) (iter.Seq[Period], error) {
+	if !p.IsBounded() {
+		return nil, fmt.Errorf("cannot split unbounded period %v", p)
+	}
+	return p.Split(NextCalendarBoundary(cal, unit)), nil
+}
+
+// NextCalendarBoundary returns a split function, usable with Period.Split,
+// giving the first boundary of given unit strictly after current.
+func NextCalendarBoundary(cal Calendar, unit CalendarUnit) func(current time.Time) time.Time {
+	return func(current time.Time) time.Time {
+		year := cal.YearOf(current)
+
+		count := 1
+		switch unit {
+		case FiscalQuarters:
+			count = 4
+		case FiscalPeriods:
+			count = 12
+		}
+
+		for n := 1; n <= count; n++ {
+			var period *Period
+			var err error
+
+			switch unit {
+			case FiscalQuarters:
+				period, err = cal.FiscalQuarter(year, n)
+			case FiscalPeriods:
+				period, err = cal.FiscalPeriod(year, n)
+			default:
+				period, err = cal.FiscalYear(year)
+			}
+
+			if err == nil && period.End.After(current) {
+				return period.End
+			}
+		}
+
+		// current is not within its fiscal year: returning it makes Split panic instead of looping forever
+		return current
+	}
+}
+
+// FiscalCalendar is a Calendar made of gregorian months, with fiscal years starting on a given month.
+type FiscalCalendar struct {
+	startMonth time.Month
+	loc        *time.Location
+}
+
+// NewFiscalCalendar creates a FiscalCalendar whose years start on the first day of startMonth in given location.
+func NewFiscalCalendar(startMonth time.Month, loc *time.Location) (*FiscalCalendar, error) {
+	if startMonth < time.January || startMonth > time.December {
+		return nil, fmt.Errorf("invalid month %d", startMonth)
+	}
+	return &FiscalCalendar{startMonth: startMonth, loc: loc}, nil
+}
+
+// FiscalYear returns the fiscal year starting in given calendar year.
+func (c *FiscalCalendar) FiscalYear(year int) (*Period, error) {
+	return FiscalYearIn(year, int(c.startMonth), c.loc)
+}
+
+// FiscalQuarter returns the given quarter of the fiscal year, made of 3 months.
+func (c *FiscalCalendar) FiscalQuarter(year int, quarter int) (*Period, error) {
+	if quarter < 1 || quarter > 4 {
+		return nil, fmt.Errorf("invalid quarter %d", quarter)
+	}
+	return c.months(year, 3*(quarter-1), 3)
+}
+
+// FiscalPeriod returns the given month of the fiscal year.
+func (c *FiscalCalendar) FiscalPeriod(year int, period int) (*Period, error) {
+	if period < 1 || period > 12 {
+		return nil, fmt.Errorf("invalid fiscal period %d", period)
+	}
+	return c.months(year, period-1, 1)
+}
+
+// YearOf returns the label of the fiscal year containing t.
+func (c *FiscalCalendar) YearOf(t time.Time) int {
+	local := t.In(c.loc)
+	if local.Month() < c.startMonth {
+		return local.Year() - 1
+	}
+	return local.Year()
+}
+
+func (c *FiscalCalendar) months(year int, offset int, count int) (*Period, error) {
+	start := time.Date(year, c.startMonth+time.Month(offset), 1, 0, 0, 0, 0, c.loc)
+	return NewPeriod(start, start.AddDate(0, count, 0))
+}
+
+// RetailCalendar is a 52/53 weeks Calendar, such as 4-4-5 retail calendars.
+// Each quarter is made of 3 fiscal periods of whole weeks, following the same pattern.
+// A fiscal year ends on the given weekday nearest to the last day of its end month;
+// the extra week of 53 weeks years is added to the last fiscal period.
+type RetailCalendar struct {
+	pattern    [3]int
+	endMonth   time.Month
+	endWeekday time.Weekday
+	loc        *time.Location
+}
+
+// NewRetailCalendar creates a RetailCalendar. pattern gives the weeks of each period in a quarter,
+// such as [3]int{4, 4, 5}, and must total 13 weeks.
+func NewRetailCalendar(pattern [3]int, endMonth time.Month, endWeekday time.Weekday, loc *time.Location) (*RetailCalendar, error) {
+	if pattern[0] < 1 || pattern[1] < 1 || pattern[2] < 1 || pattern[0]+pattern[1]+pattern[2] != 13 {
+		return nil, fmt.Errorf("invalid week pattern %v: expected 13 weeks per quarter", pattern)
+	}
+	if endMonth < time.January || endMonth > time.December {
+		return nil, fmt.Errorf("invalid month %d", endMonth)
+	}
+	return &RetailCalendar{pattern: pattern, endMonth: endMonth, endWeekday: endWeekday, loc: loc}, nil
+}
+
+// FiscalYear returns the fiscal year starting in given calendar year, lasting 52 or 53 weeks.
+func (c *RetailCalendar) FiscalYear(year int) (*Period, error) {
+	return NewPeriod(c.yearEnd(year-1), c.yearEnd(year))
+}
+
+// FiscalQuarter returns the given quarter of the fiscal year, lasting 13 weeks (14 for a last quarter of 53 weeks years).
+func (c *RetailCalendar) FiscalQuarter(year int, quarter int) (*Period, error) {
+	if quarter < 1 || quarter > 4 {
+		return nil, fmt.Errorf("invalid quarter %d", quarter)
+	}
+
+	first, err := c.FiscalPeriod(year, 3*quarter-2)
+	if err != nil {
+		return nil, err
+	}
+	last, err := c.FiscalPeriod(year, 3*quarter)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPeriod(first.Start, last.End)
+}
+
+// FiscalPeriod returns the given fiscal period of the fiscal year.
+func (c *RetailCalendar) FiscalPeriod(year int, period int) (*Period, error) {
+	if period < 1 || period > 12 {
+		return nil, fmt.Errorf("invalid fiscal period %d", period)
+	}
+
+	weeks := 0
+	for i := 0; i < period-1; i++ {
+		weeks += c.pattern[i%3]
+	}
+
+	yearStart := c.yearEnd(year - 1)
+	start := yearStart.AddDate(0, 0, 7*weeks)
+	end := start.AddDate(0, 0, 7*c.pattern[(period-1)%3])
+	if period == 12 {
+		end = c.yearEnd(year)
+	}
+
+	return NewPeriod(start, end)
+}
+
+// YearOf returns the label of the fiscal year containing t.
+func (c *RetailCalendar) YearOf(t time.Time) int {
+	year := t.In(c.loc).Year()
+	for t.Before(c.yearEnd(year - 1)) {
+		year--
+	}
+	for !t.Before(c.yearEnd(year)) {
+		year++
+	}
+	return year
+}
+
+// yearEnd returns the exclusive end of the fiscal year labelled year:
+// the day after the end weekday nearest to the last day of the end month.
+func (c *RetailCalendar) yearEnd(year int) time.Time {
+	endYear := year + 1
+	if c.endMonth == time.December {
+		endYear = year
+	}
+
+	lastDay := time.Date(endYear, c.endMonth+1, 0, 0, 0, 0, 0, c.loc)
+	offset := (int(c.endWeekday) - int(lastDay.Weekday()) + 7) % 7
+	if offset > 3 {
+		offset -= 7
+	}
+
+	return lastDay.AddDate(0, 0, offset+1)
+}
+
+var fiscalLabelPattern = regexp.MustCompile(`^FY(\d{4})(?:-(?:Q([1-4])|P(\d{2})))?$`)
+
+// ParseFiscalLabel parses a fiscal period label of given calendar.
+// Accepted labels are FY2024, FY2024-Q1 and FY2024-P03.
+func ParseFiscalLabel(label string, cal Calendar) (*Period, error) {
+	m := fiscalLabelPattern.FindStringSubmatch(label)
+	if m == nil {
+		return nil, fmt.Errorf("invalid fiscal period label %q", label)
+	}
+
+	year, _ := strconv.Atoi(m[1])
+
+	switch {
+	case m[2] != "":
+		quarter, _ := strconv.Atoi(m[2])
+		return cal.FiscalQuarter(year, quarter)
+	case m[3] != "":
+		period, _ := strconv.Atoi(m[3])
+		return cal.FiscalPeriod(year, period)
+	default:
+		return cal.FiscalYear(year)
+	}
+}
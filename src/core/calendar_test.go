@@ -0,0 +1,140 @@
+package core
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFiscalCalendar_AprilStart(t *testing.T) {
+	cal, err := NewFiscalCalendar(time.April, time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	fy, _ := cal.FiscalYear(2024)
+	if !fy.Equal(Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2025, 4, 1)}) {
+		t.Errorf("Expected FY2024 to be 2024-04-01/2025-04-01, got %v", *fy)
+	}
+
+	q4, _ := cal.FiscalQuarter(2024, 4)
+	if !q4.Equal(Period{Start: DateOnly(2025, 1, 1), End: DateOnly(2025, 4, 1)}) {
+		t.Errorf("Expected Q4 to be 2025-01-01/2025-04-01, got %v", *q4)
+	}
+
+	p12, _ := cal.FiscalPeriod(2024, 12)
+	if !p12.Equal(Period{Start: DateOnly(2025, 3, 1), End: DateOnly(2025, 4, 1)}) {
+		t.Errorf("Expected P12 to be 2025-03-01/2025-04-01, got %v", *p12)
+	}
+
+	if year := cal.YearOf(DateOnly(2025, 3, 31)); year != 2024 {
+		t.Errorf("Expected 2025-03-31 to be in FY2024, got FY%d", year)
+	}
+
+	if _, err := cal.FiscalQuarter(2024, 5); err == nil {
+		t.Errorf("Expected an error for an invalid quarter")
+	}
+}
+
+func TestRetailCalendar_445(t *testing.T) {
+	// fiscal year ending on the saturday nearest to the end of january
+	cal, err := NewRetailCalendar([3]int{4, 4, 5}, time.January, time.Saturday, time.UTC)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	fy2022, _ := cal.FiscalYear(2022)
+	if !fy2022.Equal(Period{Start: DateOnly(2022, 1, 30), End: DateOnly(2023, 1, 29)}) {
+		t.Errorf("Expected FY2022 to be 2022-01-30/2023-01-29, got %v", *fy2022)
+	}
+
+	fy2023, _ := cal.FiscalYear(2023)
+	if fy2023.Duration() != 53*7*24*time.Hour {
+		t.Errorf("Expected FY2023 to last 53 weeks, got %v", fy2023.Duration())
+	}
+
+	weeks := []int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 6}
+	for i, expected := range weeks {
+		period, _ := cal.FiscalPeriod(2023, i+1)
+		if period.Duration() != time.Duration(expected)*7*24*time.Hour {
+			t.Errorf("Expected P%d to last %d weeks, got %v", i+1, expected, period.Duration())
+		}
+	}
+
+	q1, _ := cal.FiscalQuarter(2023, 1)
+	if !q1.Equal(Period{Start: DateOnly(2023, 1, 29), End: DateOnly(2023, 4, 30)}) {
+		t.Errorf("Expected Q1 to be 2023-01-29/2023-04-30, got %v", *q1)
+	}
+
+	if year := cal.YearOf(DateOnly(2024, 2, 2)); year != 2023 {
+		t.Errorf("Expected 2024-02-02 to be in FY2023, got FY%d", year)
+	}
+
+	if _, err := NewRetailCalendar([3]int{4, 4, 4}, time.January, time.Saturday, time.UTC); err == nil {
+		t.Errorf("Expected an error for a pattern not totalling 13 weeks")
+	}
+}
+
+func TestPeriod_SplitByCalendar(t *testing.T) {
+	cal, _ := NewFiscalCalendar(time.April, time.UTC)
+	year, _ := Year(2024)
+
+	split, err := year.SplitByCalendar(cal, FiscalQuarters)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	quarters := slices.Collect(split)
+
+	expected := []Period{
+		{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 4, 1)},
+		{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 7, 1)},
+		{Start: DateOnly(2024, 7, 1), End: DateOnly(2024, 10, 1)},
+		{Start: DateOnly(2024, 10, 1), End: DateOnly(2025, 1, 1)},
+	}
+	if !slices.EqualFunc(quarters, expected, Period.Equal) {
+		t.Errorf("Expected %v, got %v", expected, quarters)
+	}
+
+	retail, _ := NewRetailCalendar([3]int{4, 4, 5}, time.January, time.Saturday, time.UTC)
+	february, _ := Month(2024, 2)
+
+	split, _ = february.SplitByCalendar(retail, FiscalPeriods)
+	periods := slices.Collect(split)
+	expected = []Period{
+		{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 4)},
+		{Start: DateOnly(2024, 2, 4), End: DateOnly(2024, 3, 1)},
+	}
+	if !slices.EqualFunc(periods, expected, Period.Equal) {
+		t.Errorf("Expected %v, got %v", expected, periods)
+	}
+
+	since := Since(DateOnly(2024, 1, 1))
+	if _, err := since.SplitByCalendar(cal, FiscalYears); err == nil {
+		t.Errorf("Expected an error for an unbounded period")
+	}
+}
+
+func TestParseFiscalLabel(t *testing.T) {
+	cal, _ := NewFiscalCalendar(time.April, time.UTC)
+
+	tests := map[string]Period{
+		"FY2024":     {Start: DateOnly(2024, 4, 1), End: DateOnly(2025, 4, 1)},
+		"FY2024-Q2":  {Start: DateOnly(2024, 7, 1), End: DateOnly(2024, 10, 1)},
+		"FY2024-P10": {Start: DateOnly(2025, 1, 1), End: DateOnly(2025, 2, 1)},
+	}
+
+	for label, expected := range tests {
+		period, err := ParseFiscalLabel(label, cal)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", label, err)
+			continue
+		}
+		if !period.Equal(expected) {
+			t.Errorf("Expected %q to be %v, got %v", label, expected, *period)
+		}
+	}
+
+	if _, err := ParseFiscalLabel("FY2024-P13", cal); err == nil {
+		t.Errorf("Expected an error for an invalid fiscal period")
+	}
+}
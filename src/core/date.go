@@ -0,0 +1,203 @@
+package core
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// Date is a civil calendar date, without clock time nor location.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the Date for given year, month and day, normalising out of range values
+// the way time.Date does (2024-02-30 is 2024-03-01).
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the Date of given time, in its location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Today returns the current Date in given location.
+func Today(loc *time.Location) Date {
+	return DateOf(time.Now().In(loc))
+}
+
+// ParseDate parses a date in ISO 8601 format (2024-01-15).
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q", s)
+	}
+	return DateOf(t), nil
+}
+
+// In returns the midnight of the date in given location.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// String formats the date in ISO 8601 format (2024-01-15).
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero checks if the date is the zero value.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid checks if the date exists in the calendar.
+func (d Date) IsValid() bool {
+	return NewDate(d.Year, d.Month, d.Day) == d
+}
+
+// Weekday returns the day of the week of the date.
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// AddDays returns the date n days later.
+func (d Date) AddDays(n int) Date {
+	return d.AddDate(0, 0, n)
+}
+
+// AddDate returns the date given years, months and days later, normalising it the way time.AddDate does.
+func (d Date) AddDate(years int, months int, days int) Date {
+	return NewDate(d.Year+years, d.Month+time.Month(months), d.Day+days)
+}
+
+// DaysSince returns the number of days from other to d.
+func (d Date) DaysSince(other Date) int {
+	return int(d.In(time.UTC).Sub(other.In(time.UTC)) / (24 * time.Hour))
+}
+
+// Compare returns -1, 0 or 1 when d is before, equal to or after other.
+func (d Date) Compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return cmp.Compare(d.Year, other.Year)
+	case d.Month != other.Month:
+		return cmp.Compare(int(d.Month), int(other.Month))
+	default:
+		return cmp.Compare(d.Day, other.Day)
+	}
+}
+
+// Before checks if d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Compare(other) < 0
+}
+
+// After checks if d is after other.
+func (d Date) After(other Date) bool {
+	return d.Compare(other) > 0
+}
+
+// MarshalText implements encoding.TextMarshaler in ISO 8601 format. The zero value is encoded as an empty text.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler in ISO 8601 format. An empty text is the zero value.
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler as an ISO 8601 string, empty for the zero value.
+func (d Date) MarshalJSON() ([]byte, error) {
+	text, _ := d.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler from an ISO 8601 string. An empty string or null is the zero value.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// DateRange is a range of civil dates, from Start included to End excluded.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// NewDateRange creates a DateRange, checking that end is after start.
+func NewDateRange(start Date, end Date) (*DateRange, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end date %v must be after start date %v", end, start)
+	}
+	return &DateRange{Start: start, End: end}, nil
+}
+
+// DateRangeOf returns the DateRange of given period in given location.
+// The period must be bounded and start and end at local midnights.
+func DateRangeOf(p Period, loc *time.Location) (*DateRange, error) {
+	if !p.IsBounded() {
+		return nil, fmt.Errorf("period %v is unbounded", p)
+	}
+
+	start := DateOf(p.Start.In(loc))
+	end := DateOf(p.End.In(loc))
+	if !start.In(loc).Equal(p.Start) || !end.In(loc).Equal(p.End) {
+		return nil, fmt.Errorf("period %v does not start and end at midnight", p)
+	}
+
+	return NewDateRange(start, end)
+}
+
+// Period returns the Period between the midnights of start and end in given location.
+func (r DateRange) Period(loc *time.Location) Period {
+	return Period{Start: r.Start.In(loc), End: r.End.In(loc)}
+}
+
+// Days returns the number of days of the range.
+func (r DateRange) Days() int {
+	return r.End.DaysSince(r.Start)
+}
+
+// Contains checks if given date is in the range. The end date is excluded.
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start) && d.Before(r.End)
+}
+
+// All returns an iterator over each date of the range.
+func (r DateRange) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for d := r.Start; d.Before(r.End); d = d.AddDays(1) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// String formats the range as an ISO 8601 interval.
+func (r DateRange) String() string {
+	return r.Start.String() + "/" + r.End.String()
+}
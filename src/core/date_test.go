@@ -0,0 +1,125 @@
+package core
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDate_Arithmetic(t *testing.T) {
+	d := NewDate(2024, time.January, 31)
+
+	if next := d.AddDate(0, 1, 0); next != NewDate(2024, time.March, 2) {
+		t.Errorf("Expected 2024-03-02, got %v", next)
+	}
+
+	if next := d.AddDays(30); next != NewDate(2024, time.March, 1) {
+		t.Errorf("Expected 2024-03-01, got %v", next)
+	}
+
+	if days := NewDate(2025, time.January, 1).DaysSince(NewDate(2024, time.January, 1)); days != 366 {
+		t.Errorf("Expected 366 days, got %d", days)
+	}
+
+	if NewDate(2024, time.February, 30) != NewDate(2024, time.March, 1) {
+		t.Errorf("Expected 2024-02-30 to be normalised to 2024-03-01")
+	}
+
+	if (Date{Year: 2023, Month: time.February, Day: 29}).IsValid() {
+		t.Errorf("Expected 2023-02-29 to be invalid")
+	}
+
+	if d.Weekday() != time.Wednesday {
+		t.Errorf("Expected wednesday, got %v", d.Weekday())
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	a := NewDate(2024, time.March, 15)
+	b := NewDate(2024, time.April, 1)
+
+	if !a.Before(b) || a.After(b) || a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("Expected %v to be before %v", a, b)
+	}
+}
+
+func TestDate_ShouldNotDependOnLocation(t *testing.T) {
+	paris := loadParis(t)
+
+	// 23:30 UTC on the 31st of March is already the 1st of April in Paris
+	instant := time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC)
+
+	if d := DateOf(instant.In(paris)); d != NewDate(2024, time.April, 1) {
+		t.Errorf("Expected 2024-04-01, got %v", d)
+	}
+
+	if midnight := NewDate(2024, time.April, 1).In(paris); !midnight.Equal(time.Date(2024, 3, 31, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected local midnight, got %v", midnight)
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	type payment struct {
+		Date Date `json:"date"`
+	}
+
+	data, err := json.Marshal(payment{Date: NewDate(2024, time.May, 5)})
+	if err != nil || string(data) != `{"date":"2024-05-05"}` {
+		t.Errorf("Expected {\"date\":\"2024-05-05\"}, got %s (%v)", data, err)
+	}
+
+	var decoded payment
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Date != NewDate(2024, time.May, 5) {
+		t.Errorf("Expected 2024-05-05, got %v (%v)", decoded.Date, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"date":"2024-02-30"}`), &decoded); err == nil {
+		t.Errorf("Expected an error for an invalid date")
+	}
+
+	// an unset date round trips as an empty string, and null reads as unset too
+	data, err = json.Marshal(payment{})
+	if err != nil || string(data) != `{"date":""}` {
+		t.Errorf("Expected {\"date\":\"\"}, got %s (%v)", data, err)
+	}
+	for _, text := range []string{string(data), `{"date":null}`} {
+		decoded = payment{Date: NewDate(2024, time.May, 5)}
+		if err := json.Unmarshal([]byte(text), &decoded); err != nil || !decoded.Date.IsZero() {
+			t.Errorf("Expected the zero date from %s, got %v (%v)", text, decoded.Date, err)
+		}
+	}
+}
+
+func TestDateRange_Period(t *testing.T) {
+	paris := loadParis(t)
+	march, _ := NewDateRange(NewDate(2024, time.March, 1), NewDate(2024, time.April, 1))
+
+	period := march.Period(paris)
+	expected, _ := MonthIn(2024, 3, paris)
+	if !period.Equal(*expected) {
+		t.Errorf("Expected %v, got %v", *expected, period)
+	}
+
+	back, err := DateRangeOf(period, paris)
+	if err != nil || *back != *march {
+		t.Errorf("Expected %v, got %v (%v)", march, back, err)
+	}
+
+	if _, err := DateRangeOf(period, time.UTC); err == nil {
+		t.Errorf("Expected an error when period is not at midnight in location")
+	}
+
+	if march.Days() != 31 || !march.Contains(NewDate(2024, time.March, 31)) || march.Contains(march.End) {
+		t.Errorf("Expected %v to contain 31 days", march)
+	}
+
+	dates := slices.Collect(march.All())
+	if len(dates) != 31 || dates[30] != NewDate(2024, time.March, 31) {
+		t.Errorf("Expected 31 dates ending on 2024-03-31, got %v", dates)
+	}
+
+	if _, err := NewDateRange(march.End, march.Start); err == nil {
+		t.Errorf("Expected an error when end is before start")
+	}
+}
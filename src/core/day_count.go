@@ -0,0 +1,128 @@
+package core
+
+import (
+	"math"
+	"time"
+)
+
+// DayCount is a day count convention, used to compute the fraction of a year covered by a
+// period for interest and fee accruals.
+type DayCount int
+
+const (
+	// Actual360 counts actual days over a 360 days year.
+	Actual360 DayCount = iota
+	// Actual365Fixed counts actual days over a 365 days year, including in leap years.
+	Actual365Fixed
+	// ActualActualISDA counts actual days in each calendar year over the length of that year.
+	ActualActualISDA
+	// Thirty360US counts 30 days months over a 360 days year, with the US (bond basis) end of month rules.
+	Thirty360US
+	// Thirty360European counts 30 days months over a 360 days year, with the Eurobond (30E/360) rules.
+	Thirty360European
+)
+
+var dayCountNames = [...]string{"ACT/360", "ACT/365F", "ACT/ACT ISDA", "30/360 US", "30E/360"}
+
+// String returns the market name of the convention.
+func (c DayCount) String() string {
+	if c < Actual360 || c > Thirty360European {
+		return "unknown"
+	}
+	return dayCountNames[c]
+}
+
+// YearFraction returns the fraction of a year covered by the period with given day count convention.
+// Bounds are taken as calendar dates in the location of the start, clock times are ignored.
+// Unbounded periods return +Inf, and empty periods return 0.
+func (p *Period) YearFraction(convention DayCount) float64 {
+	if !p.IsBounded() {
+		return math.Inf(1)
+	}
+	if p.IsEmpty() {
+		return 0
+	}
+
+	start := DateOf(p.Start)
+	end := DateOf(p.End.In(p.Start.Location()))
+
+	switch convention {
+	case Actual360:
+		return float64(end.DaysSince(start)) / 360
+	case Actual365Fixed:
+		return float64(end.DaysSince(start)) / 365
+	case ActualActualISDA:
+		return actualActualISDA(start, end)
+	case Thirty360US:
+		return thirty360US(start, end)
+	case Thirty360European:
+		return thirty360European(start, end)
+	default:
+		panic("core: unknown day count convention")
+	}
+}
+
+// Overlap returns the duration of the part of the period shared with other, and the ratio of the
+// period it represents.
+func (p *Period) Overlap(other Period) (time.Duration, float64) {
+	if !p.Intersects(other) {
+		return 0, 0
+	}
+
+	shared := Period{Start: maxTime(p.Start, other.Start), End: minTime(p.End, other.End)}
+	if shared.Equal(*p) {
+		return shared.Duration(), 1
+	}
+
+	return shared.Duration(), float64(shared.Duration()) / float64(p.Duration())
+}
+
+func actualActualISDA(start Date, end Date) float64 {
+	fraction := 0.0
+	for year := start.Year; year <= end.Year; year++ {
+		from := NewDate(year, time.January, 1)
+		to := NewDate(year+1, time.January, 1)
+		daysInYear := float64(to.DaysSince(from))
+
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		fraction += float64(to.DaysSince(from)) / daysInYear
+	}
+	return fraction
+}
+
+func thirty360US(start Date, end Date) float64 {
+	d1, d2 := start.Day, end.Day
+
+	if isLastDayOfFebruary(start) {
+		if isLastDayOfFebruary(end) {
+			d2 = 30
+		}
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+
+	return thirty360(start, end, d1, d2)
+}
+
+func thirty360European(start Date, end Date) float64 {
+	return thirty360(start, end, min(start.Day, 30), min(end.Day, 30))
+}
+
+func thirty360(start Date, end Date, d1 int, d2 int) float64 {
+	days := 360*(end.Year-start.Year) + 30*int(end.Month-start.Month) + d2 - d1
+	return float64(days) / 360
+}
+
+func isLastDayOfFebruary(d Date) bool {
+	return d.Month == time.February && d.AddDays(1).Month == time.March
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPeriod_YearFraction(t *testing.T) {
+	leapYear, _ := Year(2024)
+	acrossYears := Period{Start: DateOnly(2023, 12, 15), End: DateOnly(2024, 3, 15)}
+	endOfFebruary := Period{Start: DateOnly(2024, 2, 29), End: DateOnly(2024, 3, 31)}
+
+	tests := []struct {
+		name       string
+		period     Period
+		convention DayCount
+		expected   float64
+	}{
+		{"leap year ACT/360", *leapYear, Actual360, 366.0 / 360},
+		{"leap year ACT/365F", *leapYear, Actual365Fixed, 366.0 / 365},
+		{"leap year ACT/ACT", *leapYear, ActualActualISDA, 1},
+		{"leap year 30/360 US", *leapYear, Thirty360US, 1},
+		{"across years ACT/360", acrossYears, Actual360, 91.0 / 360},
+		{"across years ACT/ACT", acrossYears, ActualActualISDA, 17.0/365 + 74.0/366},
+		{"across years 30E/360", acrossYears, Thirty360European, 0.25},
+		{"end of february 30/360 US", endOfFebruary, Thirty360US, 30.0 / 360},
+		{"end of february 30E/360", endOfFebruary, Thirty360European, 31.0 / 360},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if fraction := tt.period.YearFraction(tt.convention); math.Abs(fraction-tt.expected) > 1e-12 {
+				t.Errorf("Expected %v, got %v", tt.expected, fraction)
+			}
+		})
+	}
+}
+
+func TestPeriod_YearFraction_ShouldUseLocalDates(t *testing.T) {
+	paris := loadParis(t)
+	march, _ := MonthIn(2024, 3, paris)
+
+	// The DST change makes March one hour short, but it still counts 31 days
+	if fraction := march.YearFraction(Actual365Fixed); fraction != 31.0/365 {
+		t.Errorf("Expected %v, got %v", 31.0/365, fraction)
+	}
+
+	open := Since(DateOnly(2024, 1, 1))
+	if fraction := open.YearFraction(Actual360); !math.IsInf(fraction, 1) {
+		t.Errorf("Expected +Inf for an unbounded period, got %v", fraction)
+	}
+}
+
+func TestPeriod_Overlap(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	duration, ratio := january.Overlap(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 2, 10)})
+	if duration != 22*24*time.Hour || ratio != 22.0/31 {
+		t.Errorf("Expected 22 days and ratio %v, got %v and %v", 22.0/31, duration, ratio)
+	}
+
+	duration, ratio = january.Overlap(Always())
+	if duration != 31*24*time.Hour || ratio != 1 {
+		t.Errorf("Expected full overlap, got %v and %v", duration, ratio)
+	}
+
+	duration, ratio = january.Overlap(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)})
+	if duration != 0 || ratio != 0 {
+		t.Errorf("Expected no overlap, got %v and %v", duration, ratio)
+	}
+}
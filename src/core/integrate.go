@@ -0,0 +1,137 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/big"
+	"time"
+)
+
+// RateUnit is the calendar unit of values which are rates, such as 300 per month.
+type RateUnit int
+
+const (
+	// PerDay rates apply to each calendar day.
+	PerDay RateUnit = iota
+	// PerMonth rates apply to each calendar month, prorated by the days of the month.
+	PerMonth
+	// PerYear rates apply to each calendar year, prorated by the days of the year.
+	PerYear
+)
+
+// Integrate returns the total of the rates of the Timeline over window, with rates in given unit.
+// Rates of overlapping items add up. Periods are measured in calendar days in the location of each
+// item, so that totals of day-granular data are exact, whatever the DST changes.
+func Integrate[T Number](t *Timeline[T], window Period, unit RateUnit) (*big.Rat, error) {
+	total := new(big.Rat)
+	for _, item := range t.FindIntersects(window) {
+		piece, err := item.Period.Clamp(window)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := integrateRate(piece, item.Value, unit)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, amount)
+	}
+	return total, nil
+}
+
+// Cumulative returns the running total of the rates of the Timeline, with rates in given unit.
+// Each item is a slice of the periods covered by the Timeline, holding the total from its start
+// to the end of the slice. Use BalanceAt for the total at a time within a slice.
+func Cumulative[T Number](t *Timeline[T], unit RateUnit) (Timeline[*big.Rat], error) {
+	if err := t.checkSorted(); err != nil {
+		return Timeline[*big.Rat]{}, err
+	}
+
+	running := new(big.Rat)
+	var items []PeriodValue[*big.Rat]
+	var err error
+	sweepSegments(t.Items, func(segment Period, active []int) bool {
+		for _, i := range active {
+			var amount *big.Rat
+			if amount, err = integrateRate(segment, t.Items[i].Value, unit); err != nil {
+				return false
+			}
+			running.Add(running, amount)
+		}
+
+		items = append(items, NewPeriodValue(segment, new(big.Rat).Set(running)))
+		return true
+	})
+	if err != nil {
+		return Timeline[*big.Rat]{}, err
+	}
+
+	return Timeline[*big.Rat]{Items: items}, nil
+}
+
+// BalanceAt returns the total of the rates of the Timeline from its start until given time, with rates in given unit.
+func BalanceAt[T Number](t *Timeline[T], at time.Time, unit RateUnit) (*big.Rat, error) {
+	if len(t.Items) == 0 || !at.After(t.Items[0].Period.Start) {
+		return new(big.Rat), nil
+	}
+	return Integrate(t, Period{Start: t.Items[0].Period.Start, End: at}, unit)
+}
+
+// integrateRate returns the total of a rate in given unit over a period.
+func integrateRate[T Number](p Period, value T, unit RateUnit) (*big.Rat, error) {
+	if !p.IsBounded() {
+		return nil, errors.New("cannot integrate over an unbounded period")
+	}
+	rate := ratOf(value)
+	if rate == nil {
+		return nil, fmt.Errorf("invalid rate %v", value)
+	}
+
+	loc := p.Start.Location()
+	var parts iter.Seq[Period]
+	switch unit {
+	case PerMonth:
+		parts = p.SplitByMonthsIn(loc)
+	case PerYear:
+		parts = p.SplitByYearsIn(loc)
+	default:
+		parts = func(yield func(Period) bool) { yield(p) }
+	}
+
+	total := new(big.Rat)
+	for part := range parts {
+		days := new(big.Rat).Sub(dayPosition(part.End, loc), dayPosition(part.Start, loc))
+		total.Add(total, days.Quo(days, unitDays(part.Start.In(loc), unit)))
+	}
+	return total.Mul(total, rate), nil
+}
+
+// dayPosition returns the number of calendar days from 1970-01-01 to t in given location,
+// with the elapsed fraction of the day of t.
+func dayPosition(t time.Time, loc *time.Location) *big.Rat {
+	local := t.In(loc)
+	date := DateOf(local)
+	midnight := date.In(loc)
+
+	position := new(big.Rat).SetInt64(int64(date.DaysSince(NewDate(1970, time.January, 1))))
+	if elapsed := local.Sub(midnight); elapsed > 0 {
+		position.Add(position, big.NewRat(int64(elapsed), int64(date.AddDays(1).In(loc).Sub(midnight))))
+	}
+	return position
+}
+
+// unitDays returns the number of days of the unit containing given local time.
+func unitDays(t time.Time, unit RateUnit) *big.Rat {
+	date := DateOf(t)
+	switch unit {
+	case PerMonth:
+		first := NewDate(date.Year, date.Month, 1)
+		return new(big.Rat).SetInt64(int64(first.AddDate(0, 1, 0).DaysSince(first)))
+	case PerYear:
+		first := NewDate(date.Year, time.January, 1)
+		return new(big.Rat).SetInt64(int64(first.AddDate(1, 0, 0).DaysSince(first)))
+	default:
+		return big.NewRat(1, 1)
+	}
+}
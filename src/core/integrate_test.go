@@ -0,0 +1,96 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestIntegrate(t *testing.T) {
+	paris := loadParis(t)
+	perMonth, _ := NewTimeLineBuilder[int]().AddQuarter(2024, 1, 300).Build()
+	perYear, _ := NewTimeLineBuilder[int]().AddYear(2024, 3660).Build()
+	perDay, _ := NewTimeLineBuilder[float64]().AddMonthIn(2024, 3, paris, 10.1).Build()
+
+	quarter, _ := Quarter(2024, 1)
+	january, _ := Month(2024, 1)
+	march, _ := MonthIn(2024, 3, paris)
+
+	tests := []struct {
+		name     string
+		total    func() (*big.Rat, error)
+		expected *big.Rat
+	}{
+		{"whole quarter per month", func() (*big.Rat, error) { return Integrate(&perMonth, *quarter, PerMonth) }, big.NewRat(900, 1)},
+		{"month and a half per month", func() (*big.Rat, error) {
+			return Integrate(&perMonth, Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 15)}, PerMonth)
+		}, big.NewRat(300*29+300*14, 29)},
+		{"unbounded window", func() (*big.Rat, error) { return Integrate(&perMonth, Since(DateOnly(2024, 3, 1)), PerMonth) }, big.NewRat(300, 1)},
+		{"january per year", func() (*big.Rat, error) { return Integrate(&perYear, *january, PerYear) }, big.NewRat(310, 1)},
+		{"DST month per day", func() (*big.Rat, error) { return Integrate(&perDay, *march, PerDay) }, big.NewRat(3131, 10)},
+		{"half a day per day", func() (*big.Rat, error) {
+			// the 31st of March lasts 23 hours in Paris
+			start := DateOnlyIn(2024, 3, 31, paris)
+			return Integrate(&perDay, Period{Start: start, End: start.Add(23 * time.Hour / 2)}, PerDay)
+		}, big.NewRat(101, 20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, err := tt.total()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if total.Cmp(tt.expected) != 0 {
+				t.Errorf("Expected %v, got %v", tt.expected, total)
+			}
+		})
+	}
+}
+
+func TestIntegrate_ShouldReadFloat32Exactly(t *testing.T) {
+	rates, _ := NewTimeLineBuilder[float32]().AddMonth(2024, 1, 0.1).Build()
+	january, _ := Month(2024, 1)
+
+	total, err := Integrate(&rates, *january, PerDay)
+	if err != nil || total.Cmp(big.NewRat(31, 10)) != 0 {
+		t.Errorf("Expected 31/10, got %v (%v)", total, err)
+	}
+}
+
+func TestCumulativeAndBalanceAt(t *testing.T) {
+	rates, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 3, 1), 100).
+		AddMonth(2024, 2, 50).
+		AddMonth(2024, 5, 10).
+		Build()
+
+	cumulative, err := Cumulative(&rates, PerMonth)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []int64{100, 250, 260}
+	if len(cumulative.Items) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, cumulative.Items)
+	}
+	for i, e := range expected {
+		if cumulative.Items[i].Value.Cmp(big.NewRat(e, 1)) != 0 {
+			t.Errorf("Expected %v, got %v", e, cumulative.Items[i].Value)
+		}
+	}
+
+	balance, err := BalanceAt(&rates, DateOnly(2024, 2, 15), PerMonth)
+	if err != nil || balance.Cmp(big.NewRat(100*29+150*14, 29)) != 0 {
+		t.Errorf("Expected %v, got %v (%v)", big.NewRat(100*29+150*14, 29), balance, err)
+	}
+
+	// the balance stays flat where no rate is defined
+	if balance, _ := BalanceAt(&rates, DateOnly(2024, 4, 15), PerMonth); balance.Cmp(big.NewRat(250, 1)) != 0 {
+		t.Errorf("Expected 250, got %v", balance)
+	}
+
+	if balance, _ := BalanceAt(&rates, DateOnly(2023, 1, 1), PerMonth); balance.Sign() != 0 {
+		t.Errorf("Expected 0 before the timeline, got %v", balance)
+	}
+}
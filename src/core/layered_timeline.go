@@ -0,0 +1,132 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Layer is a named Timeline of a LayeredTimeline.
+type Layer[T any] struct {
+	Name     string
+	Priority int
+	Enabled  bool
+	Timeline Timeline[T]
+}
+
+// LayerValue is a value of a flattened LayeredTimeline, with the name of the layer which supplied it.
+type LayerValue[T any] struct {
+	Layer string
+	Value T
+}
+
+// LayeredTimeline stacks timelines by priority, such as a base plan and its later revisions.
+// Where layers overlap, the value of the enabled layer with the highest priority wins.
+type LayeredTimeline[T any] struct {
+	// layers ordered by ascending priority, then by insertion order
+	layers []*Layer[T]
+}
+
+// NewLayeredTimeline creates a LayeredTimeline without layers.
+func NewLayeredTimeline[T any]() *LayeredTimeline[T] {
+	return &LayeredTimeline[T]{}
+}
+
+// AddLayer adds an enabled layer on top of the layers with the same or a lower priority.
+func (l *LayeredTimeline[T]) AddLayer(name string, priority int, timeline Timeline[T]) error {
+	if _, found := l.Layer(name); found {
+		return fmt.Errorf("layer %q already exists", name)
+	}
+
+	position := sort.Search(len(l.layers), func(i int) bool {
+		return l.layers[i].Priority > priority
+	})
+	l.layers = slices.Insert(l.layers, position, &Layer[T]{Name: name, Priority: priority, Enabled: true, Timeline: timeline})
+	return nil
+}
+
+// Layer returns the layer with given name.
+func (l *LayeredTimeline[T]) Layer(name string) (*Layer[T], bool) {
+	for _, layer := range l.layers {
+		if layer.Name == name {
+			return layer, true
+		}
+	}
+	return nil, false
+}
+
+// Layers returns the layers from the lowest to the highest priority.
+func (l *LayeredTimeline[T]) Layers() []*Layer[T] {
+	return slices.Clone(l.layers)
+}
+
+// Enable includes the layer with given name when flattening.
+func (l *LayeredTimeline[T]) Enable(name string) error {
+	return l.setEnabled(name, true)
+}
+
+// Disable excludes the layer with given name when flattening.
+func (l *LayeredTimeline[T]) Disable(name string) error {
+	return l.setEnabled(name, false)
+}
+
+func (l *LayeredTimeline[T]) setEnabled(name string, enabled bool) error {
+	layer, found := l.Layer(name)
+	if !found {
+		return fmt.Errorf("layer %q not found", name)
+	}
+	layer.Enabled = enabled
+	return nil
+}
+
+// layeredEntry is an item of a layer while flattening. Its rank orders items by layer then by
+// chronological order in the layer, the zero rank being the seed of ResolveConflicts.
+type layeredEntry[T any] struct {
+	rank  int
+	layer string
+	value T
+}
+
+// Flatten returns the effective timeline of the enabled layers, without overlapping items.
+// Where items overlap, the value of the highest layer wins, and the latest item within a layer.
+// Contiguous slices supplied by the same item are merged.
+func (l *LayeredTimeline[T]) Flatten() (Timeline[LayerValue[T]], error) {
+	entries := NewTimeline[layeredEntry[T]]()
+	rank := 0
+	for _, layer := range l.layers {
+		if !layer.Enabled {
+			continue
+		}
+		for _, item := range layer.Timeline.Items {
+			rank++
+			entries.Items = append(entries.Items, NewPeriodValue(item.Period, layeredEntry[T]{rank: rank, layer: layer.Name, value: item.Value}))
+		}
+	}
+	slices.SortStableFunc(entries.Items, func(a, b PeriodValue[layeredEntry[T]]) int {
+		return a.Period.Start.Compare(b.Period.Start)
+	})
+
+	resolved, err := entries.ResolveConflicts(func(p Period, a layeredEntry[T], b layeredEntry[T]) layeredEntry[T] {
+		if a.rank > b.rank {
+			return a
+		}
+		return b
+	})
+	if err != nil {
+		return Timeline[LayerValue[T]]{}, err
+	}
+
+	var items []PeriodValue[LayerValue[T]]
+	previousRank := 0
+	for _, segment := range resolved.Items {
+		if n := len(items); n > 0 && segment.Value.rank == previousRank && items[n-1].Period.IsContiguous(segment.Period) {
+			items[n-1].Period.End = segment.Period.End
+			continue
+		}
+
+		items = append(items, NewPeriodValue(segment.Period, LayerValue[T]{Layer: segment.Value.layer, Value: segment.Value.value}))
+		previousRank = segment.Value.rank
+	}
+
+	return Timeline[LayerValue[T]]{Items: items}, nil
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"testing"
+)
+
+func newBudgetLayers(t *testing.T) *LayeredTimeline[int] {
+	base, _ := NewTimeLineBuilder[int]().
+		AddYear(2024, 1000).
+		Build()
+	revision, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 3, 1), DateOnly(2024, 7, 1), 1500).
+		Build()
+	correction, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 5, 0).
+		Build()
+
+	layers := NewLayeredTimeline[int]()
+	for _, err := range []error{
+		layers.AddLayer("correction", 2, correction),
+		layers.AddLayer("base", 0, base),
+		layers.AddLayer("revision", 1, revision),
+	} {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	return layers
+}
+
+func assertLayerValues(t *testing.T, timeline Timeline[LayerValue[int]], expected []PeriodValue[LayerValue[int]]) {
+	t.Helper()
+
+	if len(timeline.Items) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, timeline.Items)
+	}
+	for i, e := range expected {
+		if !timeline.Items[i].Period.Equal(e.Period) || timeline.Items[i].Value != e.Value {
+			t.Errorf("Expected %v, got %v", e, timeline.Items[i])
+		}
+	}
+}
+
+func TestLayeredTimeline_Flatten_ShouldPreferHighestLayer(t *testing.T) {
+	layers := newBudgetLayers(t)
+
+	flat, err := layers.Flatten()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	assertLayerValues(t, flat, []PeriodValue[LayerValue[int]]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 3, 1)}, LayerValue[int]{"base", 1000}),
+		NewPeriodValue(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 5, 1)}, LayerValue[int]{"revision", 1500}),
+		NewPeriodValue(Period{Start: DateOnly(2024, 5, 1), End: DateOnly(2024, 6, 1)}, LayerValue[int]{"correction", 0}),
+		NewPeriodValue(Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2024, 7, 1)}, LayerValue[int]{"revision", 1500}),
+		NewPeriodValue(Period{Start: DateOnly(2024, 7, 1), End: DateOnly(2025, 1, 1)}, LayerValue[int]{"base", 1000}),
+	})
+}
+
+func TestLayeredTimeline_Flatten_ShouldSkipDisabledLayers(t *testing.T) {
+	layers := newBudgetLayers(t)
+
+	if err := layers.Disable("revision"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	flat, _ := layers.Flatten()
+	assertLayerValues(t, flat, []PeriodValue[LayerValue[int]]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 5, 1)}, LayerValue[int]{"base", 1000}),
+		NewPeriodValue(Period{Start: DateOnly(2024, 5, 1), End: DateOnly(2024, 6, 1)}, LayerValue[int]{"correction", 0}),
+		NewPeriodValue(Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2025, 1, 1)}, LayerValue[int]{"base", 1000}),
+	})
+
+	layers.Enable("revision")
+	if flat, _ := layers.Flatten(); len(flat.Items) != 5 {
+		t.Errorf("Expected 5 items once enabled again, got %v", flat.Items)
+	}
+}
+
+func TestLayeredTimeline_AddLayer(t *testing.T) {
+	layers := newBudgetLayers(t)
+
+	if err := layers.AddLayer("base", 3, NewTimeline[int]()); err == nil {
+		t.Errorf("Expected an error for a duplicate layer")
+	}
+
+	// a later layer with the same priority wins
+	override, _ := NewTimeLineBuilder[int]().AddMonth(2024, 5, 42).Build()
+	layers.AddLayer("override", 2, override)
+
+	var names []string
+	for _, layer := range layers.Layers() {
+		names = append(names, layer.Name)
+	}
+	if len(names) != 4 || names[0] != "base" || names[2] != "correction" || names[3] != "override" {
+		t.Errorf("Expected layers ordered by priority, got %v", names)
+	}
+
+	flat, _ := layers.Flatten()
+	if value, found := flat.ValueAt(DateOnly(2024, 5, 15)); !found || value != (LayerValue[int]{"override", 42}) {
+		t.Errorf("Expected the override value, got %v", value)
+	}
+
+	if err := layers.Disable("unknown"); err == nil {
+		t.Errorf("Expected an error for an unknown layer")
+	}
+}
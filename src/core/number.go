@@ -0,0 +1,40 @@
+package core
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// Number is the constraint of values which can be prorated, summed and averaged.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// fromFloat converts x to T, rounding it to the nearest integer when T is an integer type.
+func fromFloat[T Number](x float64) T {
+	half := 0.5
+	if T(half) == 0 {
+		return T(math.Round(x))
+	}
+	return T(x)
+}
+
+// ratOf returns v as an exact rational. Floats are read from their shortest decimal representation
+// at their own precision, so that 0.1 is exactly 1/10 for float32 too. It returns nil for NaN and infinite values.
+func ratOf[T Number](v T) *big.Rat {
+	half := 0.5
+	if T(half) == 0 {
+		return new(big.Rat).SetInt64(int64(v))
+	}
+
+	// float32 does not hold 0.1 exactly as float64 does
+	bitSize := 64
+	if tenth := 0.1; float64(T(tenth)) != tenth {
+		bitSize = 32
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(float64(v), 'g', -1, bitSize))
+	return r
+}
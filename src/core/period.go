@@ -2,6 +2,8 @@ package core
 
 import (
 	"errors"
+	"iter"
+	"math"
 	"time"
 )
 
@@ -21,24 +23,80 @@ func Empty() Period {
 	return Period{Start: time.Time{}, End: time.Time{}}
 }
 
+var (
+	// negativeInfinity is the start of periods without lower bound. It is before any other time.
+	negativeInfinity = time.Unix(-1<<62, 0).UTC()
+
+	// positiveInfinity is the end of periods without upper bound. It is after any other time.
+	positiveInfinity = time.Unix(1<<62, 0).UTC()
+)
+
+// Since returns a Period starting at given time, without end.
+func Since(start time.Time) Period {
+	return Period{Start: start, End: positiveInfinity}
+}
+
+// Until returns a Period ending at given time (exclusive), without start.
+func Until(end time.Time) Period {
+	return Period{Start: negativeInfinity, End: end}
+}
+
+// Always returns a Period without start nor end.
+func Always() Period {
+	return Period{Start: negativeInfinity, End: positiveInfinity}
+}
+
+// HasStart checks if the period has a lower bound.
+func (p *Period) HasStart() bool {
+	return p.Start.After(negativeInfinity)
+}
+
+// HasEnd checks if the period has an upper bound.
+func (p *Period) HasEnd() bool {
+	return p.End.Before(positiveInfinity)
+}
+
+// IsBounded checks if the period has both a start and an end.
+func (p *Period) IsBounded() bool {
+	return p.HasStart() && p.HasEnd()
+}
+
 // Day returns a Period for the given year, month and day.
 // The start is given day, and the end is the next day (exclusive).
 func Day(year int, month int, day int) (*Period, error) {
-	start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return DayIn(year, month, day, time.UTC)
+}
+
+// DayIn returns a Period for the given year, month and day in given location.
+// The start is the local midnight of given day, and the end is the next local midnight (exclusive),
+// so the period lasts 23 or 25 hours on DST changes.
+func DayIn(year int, month int, day int, loc *time.Location) (*Period, error) {
+	start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
 
 	nextDay := start.AddDate(0, 0, 1)
 
 	return NewPeriod(start, nextDay)
 }
 
+// DateOnly returns the midnight UTC of given day. See Date for civil dates without clock time.
 func DateOnly(year int, month int, day int) time.Time {
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return DateOnlyIn(year, month, day, time.UTC)
+}
+
+// DateOnlyIn returns the midnight of given day in given location.
+func DateOnlyIn(year int, month int, day int, loc *time.Location) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
 }
 
 // Month returns a Period for the given year and month.
 // The start is the first day of the month, and the end is the first day of the next month (exclusive).
 func Month(year int, month int) (*Period, error) {
-	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	return MonthIn(year, month, time.UTC)
+}
+
+// MonthIn returns a Period for the given year and month in given location.
+func MonthIn(year int, month int, loc *time.Location) (*Period, error) {
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
 
 	nextMonth := start.AddDate(0, 1, 0)
 
@@ -48,7 +106,12 @@ func Month(year int, month int) (*Period, error) {
 // Year returns a Period for the given year.
 // The start is the first day of the year, and the end is the first day of the next year (exclusive).
 func Year(year int) (*Period, error) {
-	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	return YearIn(year, time.UTC)
+}
+
+// YearIn returns a Period for the given year in given location.
+func YearIn(year int, loc *time.Location) (*Period, error) {
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, loc)
 
 	nextYear := start.AddDate(1, 0, 0)
 
@@ -61,60 +124,92 @@ func (p Period) Equal(other Period) bool {
 }
 
 // Duration returns duration of given period.
+// Unbounded periods return the maximum duration.
 func (p *Period) Duration() time.Duration {
+	if !p.IsBounded() {
+		return time.Duration(math.MaxInt64)
+	}
 	return p.End.Sub(p.Start)
 }
 
-// Contains check if a periods contains another one.
+// Contains checks if the period contains given time. The end of the period is excluded.
 func (p *Period) Contains(t time.Time) bool {
-	return (t.After(p.Start) || t.Equal(p.Start)) && (t.Before(p.End) || t.Equal(p.End))
+	return !t.Before(p.Start) && t.Before(p.End)
 }
 
 // ContainsPeriod checks if the current period fully contains another period.
 func (p *Period) ContainsPeriod(other Period) bool {
-	return (p.Start.Before(other.Start) || p.Start.Equal(other.Start)) &&
-		(p.End.After(other.End) || p.End.Equal(other.End))
+	return p.Is(other, RelationEquals, RelationStartedBy, RelationContains, RelationFinishedBy)
 }
 
-// Intersects checks if two periods overlap.
+// Intersects checks if two periods overlap. Periods which only meet do not overlap.
 func (p *Period) Intersects(other Period) bool {
-	return p.Start.Before(other.End) && p.End.After(other.Start)
+	return !p.Is(other, RelationPrecedes, RelationMeets, RelationMetBy, RelationPrecededBy)
 }
 
 // Split a period using given function.
-func (p *Period) Split(f func(current time.Time) time.Time) <-chan Period {
-	ch := make(chan Period)
-
-	go func() {
-		defer close(ch)
+// The function receives the start of each sub period and returns its end. It
+// must move forward: Split panics if f returns a time that is not after current.
+// The last sub period is cut at the end of the period.
+// Unbounded periods cannot be split: Split yields nothing for them, so callers must check IsBounded
+// or clamp them first.
+func (p *Period) Split(f func(current time.Time) time.Time) iter.Seq[Period] {
+	return func(yield func(Period) bool) {
+		if !p.IsBounded() {
+			return
+		}
 
 		current := p.Start
 		for current.Before(p.End) {
 			next := f(current)
-			ch <- Period{Start: current, End: next}
+			if !next.After(current) {
+				panic("core: split function must return a time after " + current.String())
+			}
+			next = minTime(next, p.End)
+			if !yield(Period{Start: current, End: next}) {
+				return
+			}
 			current = next
 		}
-	}()
-
-	return ch
+	}
 }
 
 // SplitByDays returns periods for each days in given period.
-func (p *Period) SplitByDays() <-chan Period {
-	return p.Split(func(current time.Time) time.Time { return current.AddDate(0, 0, 1) })
+// Days are cut at midnight in the location of the period start.
+func (p *Period) SplitByDays() iter.Seq[Period] {
+	return p.SplitByDaysIn(p.Start.Location())
+}
+
+// SplitByDaysIn returns periods for each days in given period, cut at local midnights of given location.
+func (p *Period) SplitByDaysIn(loc *time.Location) iter.Seq[Period] {
+	return p.Split(func(current time.Time) time.Time {
+		y, m, d := current.In(loc).Date()
+		return time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+	})
 }
 
 // SplitByMonths returns periods for each months in given period.
-func (p *Period) SplitByMonths() <-chan Period {
-	return p.Split(func(current time.Time) time.Time { return current.AddDate(0, 1, 0) })
+// Months are cut at the first day of month in the location of the period start.
+func (p *Period) SplitByMonths() iter.Seq[Period] {
+	return p.SplitByMonthsIn(p.Start.Location())
+}
+
+// SplitByMonthsIn returns periods for each months in given period, cut at the first day of month in given location.
+func (p *Period) SplitByMonthsIn(loc *time.Location) iter.Seq[Period] {
+	return p.Split(func(current time.Time) time.Time {
+		y, m, _ := current.In(loc).Date()
+		return time.Date(y, m+1, 1, 0, 0, 0, 0, loc)
+	})
 }
 
+// Before checks if the period ends before, or when, the other one starts.
 func (p *Period) Before(other Period) bool {
-	return p.End.Before(other.Start) || p.End.Equal(other.Start)
+	return p.Is(other, RelationPrecedes, RelationMeets)
 }
 
+// After checks if the period starts after, or when, the other one ends.
 func (p *Period) After(other Period) bool {
-	return p.Start.After(other.End) || p.Start.Equal(other.End)
+	return p.Is(other, RelationPrecededBy, RelationMetBy)
 }
 
 // Helper function to find the minimum of two times
@@ -134,39 +229,31 @@ func maxTime(t1, t2 time.Time) time.Time {
 }
 
 // SplitFromPeriod returns a split of periods intersecting with given period
-func (p *Period) SplitFromPeriod(period Period) <-chan Period {
-	ch := make(chan Period)
-
-	go func() {
-		defer close(ch)
-
+func (p *Period) SplitFromPeriod(period Period) iter.Seq[Period] {
+	return func(yield func(Period) bool) {
 		if !p.Intersects(period) {
 			return
 		}
 
 		// before intersecting part
 		if p.Start.Before(period.Start) {
-			ch <- Period{Start: p.Start, End: period.Start}
+			if !yield(Period{Start: p.Start, End: period.Start}) {
+				return
+			}
 		}
 
 		// intersecting part
 		overlapStart := maxTime(p.Start, period.Start)
 		overlapEnd := minTime(p.End, period.End)
-		ch <- Period{
-			Start: overlapStart,
-			End:   overlapEnd,
+		if !yield(Period{Start: overlapStart, End: overlapEnd}) {
+			return
 		}
 
 		// after intersecting part
 		if p.End.After(period.End) {
-			ch <- Period{
-				Start: period.End,
-				End:   p.End,
-			}
+			yield(Period{Start: period.End, End: p.End})
 		}
-	}()
-
-	return ch
+	}
 }
 
 // IsEmpty checks if period is empty
@@ -190,5 +277,5 @@ func (p *Period) Clamp(limit Period) (Period, error) {
 
 // IsContiguous checks if the other Period is contiguous
 func (p *Period) IsContiguous(other Period) bool {
-	return p.End.Equal(other.Start) || p.Start.Equal(other.End)
+	return p.Is(other, RelationMeets, RelationMetBy)
 }
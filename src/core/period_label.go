@@ -0,0 +1,169 @@
+package core
+
+import (
+	"fmt"
+	"iter"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Quarter returns a Period for the given year and quarter (1 to 4).
+func Quarter(year int, quarter int) (*Period, error) {
+	return QuarterIn(year, quarter, time.UTC)
+}
+
+// QuarterIn returns a Period for the given year and quarter (1 to 4) in given location.
+func QuarterIn(year int, quarter int, loc *time.Location) (*Period, error) {
+	if quarter < 1 || quarter > 4 {
+		return nil, fmt.Errorf("invalid quarter %d", quarter)
+	}
+
+	start := time.Date(year, time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, loc)
+
+	return NewPeriod(start, start.AddDate(0, 3, 0))
+}
+
+// Half returns a Period for the given year and half (1 or 2).
+func Half(year int, half int) (*Period, error) {
+	return HalfIn(year, half, time.UTC)
+}
+
+// HalfIn returns a Period for the given year and half (1 or 2) in given location.
+func HalfIn(year int, half int, loc *time.Location) (*Period, error) {
+	if half < 1 || half > 2 {
+		return nil, fmt.Errorf("invalid half %d", half)
+	}
+
+	start := time.Date(year, time.Month(6*(half-1)+1), 1, 0, 0, 0, 0, loc)
+
+	return NewPeriod(start, start.AddDate(0, 6, 0))
+}
+
+// ISOWeek returns a Period for the given ISO 8601 year and week.
+// Weeks start on monday, and week 1 is the week containing the 4th of January.
+func ISOWeek(year int, week int) (*Period, error) {
+	return ISOWeekIn(year, week, time.UTC)
+}
+
+// ISOWeekIn returns a Period for the given ISO 8601 year and week in given location.
+func ISOWeekIn(year int, week int, loc *time.Location) (*Period, error) {
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	start := jan4.AddDate(0, 0, -daysSinceMonday(jan4)+7*(week-1))
+
+	if y, w := start.ISOWeek(); week < 1 || y != year || w != week {
+		return nil, fmt.Errorf("invalid week %d for year %d", week, year)
+	}
+
+	return NewPeriod(start, start.AddDate(0, 0, 7))
+}
+
+// FiscalYear returns a Period for a fiscal year starting on the first day of startMonth.
+// Fiscal years are labelled by the calendar year they start in.
+func FiscalYear(year int, startMonth int) (*Period, error) {
+	return FiscalYearIn(year, startMonth, time.UTC)
+}
+
+// FiscalYearIn returns a Period for a fiscal year starting on the first day of startMonth in given location.
+func FiscalYearIn(year int, startMonth int, loc *time.Location) (*Period, error) {
+	if startMonth < 1 || startMonth > 12 {
+		return nil, fmt.Errorf("invalid month %d", startMonth)
+	}
+
+	start := time.Date(year, time.Month(startMonth), 1, 0, 0, 0, 0, loc)
+
+	return NewPeriod(start, start.AddDate(1, 0, 0))
+}
+
+var labelPattern = regexp.MustCompile(`^(?:FY(\d{4})|(\d{4})(?:-(?:Q([1-4])|H([12])|W(\d{2})|(\d{2})(?:-(\d{2}))?))?)$`)
+
+// ParseLabel parses a shorthand period label in UTC.
+// Accepted labels are 2024, 2024-H2, 2024-Q1, 2024-01, 2024-W05, 2024-01-15 and FY2024.
+// FY2024 is the fiscal year starting in January 2024; see Calendar for other fiscal years.
+func ParseLabel(label string) (*Period, error) {
+	return ParseLabelIn(label, time.UTC)
+}
+
+// ParseLabelIn parses a shorthand period label in given location.
+func ParseLabelIn(label string, loc *time.Location) (*Period, error) {
+	m := labelPattern.FindStringSubmatch(label)
+	if m == nil {
+		return nil, fmt.Errorf("invalid period label %q", label)
+	}
+
+	atoi := func(s string) int {
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+
+	switch {
+	case m[1] != "":
+		return FiscalYearIn(atoi(m[1]), 1, loc)
+	case m[3] != "":
+		return QuarterIn(atoi(m[2]), atoi(m[3]), loc)
+	case m[4] != "":
+		return HalfIn(atoi(m[2]), atoi(m[4]), loc)
+	case m[5] != "":
+		return ISOWeekIn(atoi(m[2]), atoi(m[5]), loc)
+	case m[7] != "":
+		year, month, day := atoi(m[2]), atoi(m[6]), atoi(m[7])
+		if t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc); t.Month() != time.Month(month) || t.Day() != day {
+			return nil, fmt.Errorf("invalid period label %q", label)
+		}
+		return DayIn(year, month, day, loc)
+	case m[6] != "":
+		if month := atoi(m[6]); month < 1 || month > 12 {
+			return nil, fmt.Errorf("invalid period label %q", label)
+		}
+		return MonthIn(atoi(m[2]), atoi(m[6]), loc)
+	default:
+		return YearIn(atoi(m[2]), loc)
+	}
+}
+
+// SplitByWeeks returns periods for each ISO weeks in given period.
+// Weeks are cut on mondays at midnight in the location of the period start.
+func (p *Period) SplitByWeeks() iter.Seq[Period] {
+	return p.SplitByWeeksIn(p.Start.Location())
+}
+
+// SplitByWeeksIn returns periods for each ISO weeks in given period, cut on mondays at midnight in given location.
+func (p *Period) SplitByWeeksIn(loc *time.Location) iter.Seq[Period] {
+	return p.Split(func(current time.Time) time.Time {
+		local := current.In(loc)
+		y, m, d := local.Date()
+		return time.Date(y, m, d+7-daysSinceMonday(local), 0, 0, 0, 0, loc)
+	})
+}
+
+// SplitByQuarters returns periods for each quarters in given period.
+// Quarters are cut at the first day of january, april, july and october in the location of the period start.
+func (p *Period) SplitByQuarters() iter.Seq[Period] {
+	return p.SplitByQuartersIn(p.Start.Location())
+}
+
+// SplitByQuartersIn returns periods for each quarters in given period, cut in given location.
+func (p *Period) SplitByQuartersIn(loc *time.Location) iter.Seq[Period] {
+	return p.Split(func(current time.Time) time.Time {
+		y, m, _ := current.In(loc).Date()
+		return time.Date(y, (m-1)/3*3+4, 1, 0, 0, 0, 0, loc)
+	})
+}
+
+// SplitByYears returns periods for each years in given period.
+// Years are cut at the first day of january in the location of the period start.
+func (p *Period) SplitByYears() iter.Seq[Period] {
+	return p.SplitByYearsIn(p.Start.Location())
+}
+
+// SplitByYearsIn returns periods for each years in given period, cut in given location.
+func (p *Period) SplitByYearsIn(loc *time.Location) iter.Seq[Period] {
+	return p.Split(func(current time.Time) time.Time {
+		return time.Date(current.In(loc).Year()+1, 1, 1, 0, 0, 0, 0, loc)
+	})
+}
+
+// daysSinceMonday returns the number of days between the previous monday and t.
+func daysSinceMonday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		start time.Time
+		end   time.Time
+	}{
+		{label: "2024", start: DateOnly(2024, 1, 1), end: DateOnly(2025, 1, 1)},
+		{label: "2024-H2", start: DateOnly(2024, 7, 1), end: DateOnly(2025, 1, 1)},
+		{label: "2024-Q1", start: DateOnly(2024, 1, 1), end: DateOnly(2024, 4, 1)},
+		{label: "2024-Q4", start: DateOnly(2024, 10, 1), end: DateOnly(2025, 1, 1)},
+		{label: "2024-01", start: DateOnly(2024, 1, 1), end: DateOnly(2024, 2, 1)},
+		{label: "2024-02-29", start: DateOnly(2024, 2, 29), end: DateOnly(2024, 3, 1)},
+		{label: "2024-W05", start: DateOnly(2024, 1, 29), end: DateOnly(2024, 2, 5)},
+		{label: "2021-W01", start: DateOnly(2021, 1, 4), end: DateOnly(2021, 1, 11)},
+		{label: "2020-W53", start: DateOnly(2020, 12, 28), end: DateOnly(2021, 1, 4)},
+		{label: "FY2024", start: DateOnly(2024, 1, 1), end: DateOnly(2025, 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			period, err := ParseLabel(tt.label)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !period.Start.Equal(tt.start) || !period.End.Equal(tt.end) {
+				t.Errorf("Expected %v - %v, got %v", tt.start, tt.end, *period)
+			}
+		})
+	}
+}
+
+func TestParseLabel_Invalid(t *testing.T) {
+	for _, label := range []string{"", "24", "2024-Q5", "2024-H3", "2024-13", "2023-02-29", "2024-W00", "2021-W53", "FY24", "2024-q1"} {
+		if _, err := ParseLabel(label); err == nil {
+			t.Errorf("Expected an error for %q", label)
+		}
+	}
+}
+
+func TestFiscalYear_ShouldStartOnGivenMonth(t *testing.T) {
+	fy, err := FiscalYear(2024, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !fy.Start.Equal(DateOnly(2024, 4, 1)) || !fy.End.Equal(DateOnly(2025, 4, 1)) {
+		t.Errorf("Expected 2024-04-01/2025-04-01, got %v", *fy)
+	}
+}
+
+func TestPeriod_SplitByWeeks_ShouldCutOnMondays(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	weeks := slices.Collect(january.SplitByWeeks())
+
+	if len(weeks) != 5 {
+		t.Fatalf("Expected 5 weeks, got %v", len(weeks))
+	}
+
+	for _, week := range weeks[1:] {
+		if week.Start.Weekday() != time.Monday {
+			t.Errorf("Expected week to start on monday, got %v", week.Start.Weekday())
+		}
+	}
+
+	if !weeks[4].Equal(Period{Start: DateOnly(2024, 1, 29), End: DateOnly(2024, 2, 1)}) {
+		t.Errorf("Expected last week to be cut at end of january, got %v", weeks[4])
+	}
+}
+
+func TestPeriod_SplitByQuartersAndYears(t *testing.T) {
+	period, _ := NewPeriod(DateOnly(2023, 11, 15), DateOnly(2025, 2, 1))
+
+	quarters := slices.Collect(period.SplitByQuarters())
+	expectedQuarters := []Period{
+		{Start: DateOnly(2023, 11, 15), End: DateOnly(2024, 1, 1)},
+		{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 4, 1)},
+		{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 7, 1)},
+		{Start: DateOnly(2024, 7, 1), End: DateOnly(2024, 10, 1)},
+		{Start: DateOnly(2024, 10, 1), End: DateOnly(2025, 1, 1)},
+		{Start: DateOnly(2025, 1, 1), End: DateOnly(2025, 2, 1)},
+	}
+	if !slices.EqualFunc(quarters, expectedQuarters, Period.Equal) {
+		t.Errorf("Expected %v, got %v", expectedQuarters, quarters)
+	}
+
+	years := slices.Collect(period.SplitByYears())
+	expectedYears := []Period{
+		{Start: DateOnly(2023, 11, 15), End: DateOnly(2024, 1, 1)},
+		{Start: DateOnly(2024, 1, 1), End: DateOnly(2025, 1, 1)},
+		{Start: DateOnly(2025, 1, 1), End: DateOnly(2025, 2, 1)},
+	}
+	if !slices.EqualFunc(years, expectedYears, Period.Equal) {
+		t.Errorf("Expected %v, got %v", expectedYears, years)
+	}
+}
+
+func TestTimeLineBuilder_AddQuarterWeekAndYear(t *testing.T) {
+	timeline, err := NewTimeLineBuilder[int]().
+		AddYear(2024, 1200).
+		AddQuarter(2024, 2, 300).
+		AddWeek(2024, 5, 70).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	expected := []string{"2024-01-01/2025-01-01", "2024-01-29/2024-02-05", "2024-04-01/2024-07-01"}
+	for i, item := range timeline.Items {
+		if item.Period.String() != expected[i] {
+			t.Errorf("Expected period to be %v, got %v", expected[i], item.Period)
+		}
+	}
+
+	_, err = NewTimeLineBuilder[int]().AddQuarter(2024, 5, 100).Build()
+	if err == nil {
+		t.Errorf("Expected an error for an invalid quarter")
+	}
+}
@@ -0,0 +1,157 @@
+package core
+
+import (
+	"iter"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PeriodSet is a normalised set of periods: disjoint, sorted chronologically,
+// with overlapping or contiguous periods merged and empty periods dropped.
+type PeriodSet struct {
+	periods []Period
+}
+
+// NewPeriodSet creates a PeriodSet covering all given periods.
+func NewPeriodSet(periods ...Period) PeriodSet {
+	sorted := make([]Period, 0, len(periods))
+	for _, p := range periods {
+		if !p.IsEmpty() {
+			sorted = append(sorted, p)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Period) int { return a.Start.Compare(b.Start) })
+
+	var merged []Period
+	for _, p := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && !p.Start.After(merged[last].End) {
+			merged[last].End = maxTime(merged[last].End, p.End)
+			continue
+		}
+		merged = append(merged, p)
+	}
+
+	return PeriodSet{periods: merged}
+}
+
+// Periods returns a copy of the disjoint periods of the set.
+func (s PeriodSet) Periods() []Period {
+	return slices.Clone(s.periods)
+}
+
+// All returns an iterator over the disjoint periods of the set, in chronological order.
+func (s PeriodSet) All() iter.Seq[Period] {
+	return slices.Values(s.periods)
+}
+
+// Len returns the number of disjoint periods of the set.
+func (s PeriodSet) Len() int {
+	return len(s.periods)
+}
+
+// IsEmpty checks if the set covers nothing.
+func (s PeriodSet) IsEmpty() bool {
+	return len(s.periods) == 0
+}
+
+// Equal compares two sets.
+func (s PeriodSet) Equal(other PeriodSet) bool {
+	return slices.EqualFunc(s.periods, other.periods, Period.Equal)
+}
+
+// Duration returns the total duration covered by the set.
+// Sets covering an unbounded period return the maximum duration.
+func (s PeriodSet) Duration() time.Duration {
+	var total time.Duration
+	for _, p := range s.periods {
+		if !p.IsBounded() {
+			return time.Duration(math.MaxInt64)
+		}
+		total += p.Duration()
+	}
+	return total
+}
+
+// Contains checks if t is covered by the set. Periods are end-exclusive.
+func (s PeriodSet) Contains(t time.Time) bool {
+	// first period ending after t
+	i := sort.Search(len(s.periods), func(i int) bool { return s.periods[i].End.After(t) })
+	return i < len(s.periods) && !t.Before(s.periods[i].Start)
+}
+
+// Union returns the set covering periods of both sets.
+func (s PeriodSet) Union(other PeriodSet) PeriodSet {
+	return NewPeriodSet(append(slices.Clone(s.periods), other.periods...)...)
+}
+
+// Intersect returns the set covering periods common to both sets.
+func (s PeriodSet) Intersect(other PeriodSet) PeriodSet {
+	var result []Period
+
+	i, j := 0, 0
+	for i < len(s.periods) && j < len(other.periods) {
+		a, b := s.periods[i], other.periods[j]
+
+		start := maxTime(a.Start, b.Start)
+		end := minTime(a.End, b.End)
+		if start.Before(end) {
+			result = append(result, Period{Start: start, End: end})
+		}
+
+		// move forward the period ending first
+		if a.End.Before(b.End) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return PeriodSet{periods: result}
+}
+
+// Subtract returns the set covering periods of s not covered by other.
+func (s PeriodSet) Subtract(other PeriodSet) PeriodSet {
+	var result []Period
+
+	j := 0
+	for _, p := range s.periods {
+		current := p.Start
+
+		// skip removed periods ending before p
+		for j < len(other.periods) && !other.periods[j].End.After(current) {
+			j++
+		}
+
+		for k := j; k < len(other.periods) && other.periods[k].Start.Before(p.End); k++ {
+			removed := other.periods[k]
+			if current.Before(removed.Start) {
+				result = append(result, Period{Start: current, End: removed.Start})
+			}
+			current = maxTime(current, removed.End)
+		}
+
+		if current.Before(p.End) {
+			result = append(result, Period{Start: current, End: p.End})
+		}
+	}
+
+	return PeriodSet{periods: result}
+}
+
+// Complement returns the set covering the parts of within not covered by s.
+func (s PeriodSet) Complement(within Period) PeriodSet {
+	return NewPeriodSet(within).Subtract(s)
+}
+
+// String formats the set as a list of ISO 8601 intervals.
+func (s PeriodSet) String() string {
+	texts := make([]string, len(s.periods))
+	for i, p := range s.periods {
+		texts[i] = p.String()
+	}
+	return "[" + strings.Join(texts, " ") + "]"
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func januaryDays(from int, to int) Period {
+	return Period{Start: DateOnly(2024, 1, from), End: DateOnly(2024, 1, to)}
+}
+
+func TestNewPeriodSet_ShouldNormalisePeriods(t *testing.T) {
+	set := NewPeriodSet(januaryDays(10, 15), januaryDays(1, 5), januaryDays(5, 8), januaryDays(12, 20), januaryDays(25, 25))
+
+	expected := []Period{januaryDays(1, 8), januaryDays(10, 20)}
+	if !set.Equal(PeriodSet{periods: expected}) {
+		t.Errorf("Expected %v, got %v", expected, set)
+	}
+
+	if set.Duration() != 17*24*time.Hour {
+		t.Errorf("Expected 17 days, got %v", set.Duration())
+	}
+}
+
+func TestPeriodSet_Operations(t *testing.T) {
+	a := NewPeriodSet(januaryDays(1, 10), januaryDays(15, 25))
+	b := NewPeriodSet(januaryDays(5, 17), januaryDays(20, 22), januaryDays(24, 30))
+
+	tests := []struct {
+		name     string
+		result   PeriodSet
+		expected PeriodSet
+	}{
+		{"union", a.Union(b), NewPeriodSet(januaryDays(1, 30))},
+		{"intersect", a.Intersect(b), NewPeriodSet(januaryDays(5, 10), januaryDays(15, 17), januaryDays(20, 22), januaryDays(24, 25))},
+		{"subtract", a.Subtract(b), NewPeriodSet(januaryDays(1, 5), januaryDays(17, 20), januaryDays(22, 24))},
+		{"subtract from other", b.Subtract(a), NewPeriodSet(januaryDays(10, 15), januaryDays(25, 30))},
+		{"complement", a.Complement(januaryDays(3, 31)), NewPeriodSet(januaryDays(10, 15), januaryDays(25, 31))},
+		{"complement of empty set", PeriodSet{}.Complement(januaryDays(3, 31)), NewPeriodSet(januaryDays(3, 31))},
+		{"intersect with empty set", a.Intersect(PeriodSet{}), PeriodSet{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.result.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, tt.result)
+			}
+		})
+	}
+}
+
+func TestPeriodSet_Contains(t *testing.T) {
+	set := NewPeriodSet(januaryDays(1, 10), januaryDays(15, 25))
+
+	tests := map[time.Time]bool{
+		DateOnly(2023, 12, 31): false,
+		DateOnly(2024, 1, 1):   true,
+		DateOnly(2024, 1, 9):   true,
+		DateOnly(2024, 1, 10):  false,
+		DateOnly(2024, 1, 15):  true,
+		DateOnly(2024, 1, 25):  false,
+	}
+
+	for date, expected := range tests {
+		if set.Contains(date) != expected {
+			t.Errorf("Expected Contains(%v) to be %v", date.Format(dateLayout), expected)
+		}
+	}
+}
+
+func TestTimeline_Coverage_ShouldFindUncoveredPeriods(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 2, 200).
+		AddMonth(2024, 5, 500).
+		AddPeriod(DateOnly(2024, 4, 15), DateOnly(2024, 5, 15), 50).
+		Build()
+
+	year, _ := Year(2024)
+	uncovered := timeline.Coverage().Complement(*year)
+
+	expected := NewPeriodSet(
+		Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 15)},
+		Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2025, 1, 1)},
+	)
+	if !uncovered.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, uncovered)
+	}
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"math"
+	"slices"
 	"testing"
 	"time"
 )
@@ -183,15 +185,41 @@ func TestPeriod_SplitYear2024ByMonths(t *testing.T) {
 	}
 }
 
+func TestPeriod_SplitByDays_ShouldStopWhenBreaking(t *testing.T) {
+	period, _ := Year(2024)
+	count := 0
+
+	for range period.SplitByDays() {
+		count++
+		if count == 10 {
+			break
+		}
+	}
+
+	if count != 10 {
+		t.Errorf("expected 10 days, got %v", count)
+	}
+}
+
+func TestPeriod_Split_ShouldPanicWhenNotMovingForward(t *testing.T) {
+	period, _ := Month(2024, 1)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic when split function does not move forward")
+		}
+	}()
+
+	for range period.Split(func(current time.Time) time.Time { return current }) {
+	}
+}
+
 func TestSplitFromPeriod_NoIntersection(t *testing.T) {
 	p, _ := Month(2024, 1)
 
 	nonIntersectingPeriod, _ := Month(2024, 3)
 
-	splitChan := p.SplitFromPeriod(*nonIntersectingPeriod)
-
-	_, ok := <-splitChan
-	if ok {
+	for range p.SplitFromPeriod(*nonIntersectingPeriod) {
 		t.Errorf("Expected no periods, but got a value")
 	}
 }
@@ -200,12 +228,7 @@ func TestSplitFromPeriod_ShouldReturn3Periods(t *testing.T) {
 	feb2024, _ := Month(2024, 2)
 	intersectingPeriod, _ := Day(2024, 2, 15)
 
-	splitChan := feb2024.SplitFromPeriod(*intersectingPeriod)
-
-	var results []Period
-	for p := range splitChan {
-		results = append(results, p)
-	}
+	results := slices.Collect(feb2024.SplitFromPeriod(*intersectingPeriod))
 
 	if len(results) != 3 {
 		t.Errorf("Expected 3 periods, got %v", len(results))
@@ -380,3 +403,145 @@ func TestPeriod_Clamp(t *testing.T) {
 		})
 	}
 }
+
+func loadParis(t *testing.T) *time.Location {
+	t.Helper()
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("Europe/Paris location unavailable: %v", err)
+	}
+	return paris
+}
+
+func TestDayIn_ShouldFollowDSTChanges(t *testing.T) {
+	paris := loadParis(t)
+
+	spring, _ := DayIn(2024, 3, 31, paris)
+	if spring.Duration() != 23*time.Hour {
+		t.Errorf("expected 23h for spring DST day, got %v", spring.Duration())
+	}
+
+	autumn, _ := DayIn(2024, 10, 27, paris)
+	if autumn.Duration() != 25*time.Hour {
+		t.Errorf("expected 25h for autumn DST day, got %v", autumn.Duration())
+	}
+}
+
+func TestMonthIn_ShouldStartAtLocalMidnight(t *testing.T) {
+	paris := loadParis(t)
+
+	january, _ := MonthIn(2024, 1, paris)
+
+	expectedStart := time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC)
+	if !january.Start.Equal(expectedStart) {
+		t.Errorf("expected start %v, got %v", expectedStart, january.Start)
+	}
+
+	if !january.End.Equal(DateOnlyIn(2024, 2, 1, paris)) {
+		t.Errorf("expected end %v, got %v", DateOnlyIn(2024, 2, 1, paris), january.End)
+	}
+}
+
+func TestPeriod_SplitByDays_ShouldRespectLocalMidnightsAcrossDST(t *testing.T) {
+	paris := loadParis(t)
+	march, _ := MonthIn(2024, 3, paris)
+
+	count := 0
+	for d := range march.SplitByDays() {
+		count++
+		local := d.Start.In(paris)
+		if local.Hour() != 0 || local.Minute() != 0 {
+			t.Errorf("expected day to start at local midnight, got %v", local)
+		}
+		if local.Day() == 31 && d.Duration() != 23*time.Hour {
+			t.Errorf("expected 23h for 31 March, got %v", d.Duration())
+		}
+	}
+
+	if count != 31 {
+		t.Errorf("expected 31 days, got %v", count)
+	}
+}
+
+func TestPeriod_SplitByDaysIn_ShouldCutUTCPeriodAtLocalMidnights(t *testing.T) {
+	paris := loadParis(t)
+	day, _ := Day(2024, 7, 14)
+
+	days := slices.Collect(day.SplitByDaysIn(paris))
+
+	if len(days) != 2 {
+		t.Fatalf("expected 2 periods, got %v", len(days))
+	}
+
+	if !days[0].End.Equal(time.Date(2024, 7, 14, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected first period to end at local midnight, got %v", days[0].End)
+	}
+
+	if !days[1].End.Equal(day.End) {
+		t.Errorf("expected last period to end at %v, got %v", day.End, days[1].End)
+	}
+}
+
+func TestPeriod_SplitByMonths_ShouldCutAtMonthBoundaries(t *testing.T) {
+	period, _ := NewPeriod(DateOnly(2024, 1, 15), DateOnly(2024, 3, 10))
+
+	months := slices.Collect(period.SplitByMonths())
+
+	expected := []Period{
+		{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 2, 1)},
+		{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)},
+		{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 3, 10)},
+	}
+
+	if !slices.EqualFunc(months, expected, Period.Equal) {
+		t.Errorf("expected %v, got %v", expected, months)
+	}
+}
+
+func TestPeriod_OpenEnded(t *testing.T) {
+	since := Since(DateOnly(2024, 3, 1))
+	until := Until(DateOnly(2024, 3, 1))
+	always := Always()
+
+	if !since.HasStart() || since.HasEnd() || since.IsBounded() {
+		t.Errorf("Expected %v to have a start and no end", since)
+	}
+
+	if until.HasStart() || !until.HasEnd() || until.IsBounded() {
+		t.Errorf("Expected %v to have an end and no start", until)
+	}
+
+	if !always.Contains(DateOnly(9999, 12, 31)) || !always.Contains(DateOnly(1, 1, 1)) {
+		t.Errorf("Expected %v to contain any time", always)
+	}
+
+	if since.Duration() != time.Duration(math.MaxInt64) {
+		t.Errorf("Expected unbounded period to have maximum duration, got %v", since.Duration())
+	}
+
+	if !since.IsContiguous(until) {
+		t.Errorf("Expected %v to be contiguous with %v", since, until)
+	}
+
+	year, _ := Year(2024)
+	clamped, err := since.Clamp(*year)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2025, 1, 1)}
+	if !clamped.Equal(expected) || !clamped.IsBounded() {
+		t.Errorf("Expected %v, got %v", expected, clamped)
+	}
+}
+
+func TestPeriod_Split_ShouldYieldNothingWhenUnbounded(t *testing.T) {
+	for _, p := range []Period{Since(DateOnly(2024, 3, 1)), Until(DateOnly(2024, 3, 1)), Always()} {
+		if months := slices.Collect(p.SplitByMonths()); len(months) != 0 {
+			t.Errorf("Expected no month in %v, got %v", p, months)
+		}
+		if days := slices.Collect(p.SplitByDaysIn(time.UTC)); len(days) != 0 {
+			t.Errorf("Expected no day in %v, got %v", p, days)
+		}
+	}
+}
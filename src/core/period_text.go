@@ -0,0 +1,238 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// timeLayouts are the accepted layouts for each bound of an ISO 8601 interval.
+// Layouts without offset are read as UTC.
+var timeLayouts = []string{
+	dateLayout,
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+}
+
+// ParsePeriod parses an ISO 8601 time interval.
+// Accepted forms are start/end (2024-01-01/2024-02-01), start/duration (2024-01-01/P1M)
+// and duration/end (P1M/2024-02-01). Open bounds are written '..' (2024-03-01/..).
+func ParsePeriod(s string) (*Period, error) {
+	startText, endText, found := strings.Cut(s, "/")
+	if !found || strings.Contains(endText, "/") {
+		return nil, fmt.Errorf("invalid interval %q: expected exactly one '/'", s)
+	}
+
+	if startText == openBound || endText == openBound {
+		return parseOpenPeriod(s, startText, endText)
+	}
+
+	startIsDuration := strings.HasPrefix(startText, "P")
+	endIsDuration := strings.HasPrefix(endText, "P")
+
+	switch {
+	case startIsDuration && endIsDuration:
+		return nil, fmt.Errorf("invalid interval %q: both bounds are durations", s)
+
+	case startIsDuration:
+		d, err := parseISODuration(startText)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseISOTime(endText)
+		if err != nil {
+			return nil, err
+		}
+		return NewPeriod(d.addTo(end, -1), end)
+
+	case endIsDuration:
+		start, err := parseISOTime(startText)
+		if err != nil {
+			return nil, err
+		}
+		d, err := parseISODuration(endText)
+		if err != nil {
+			return nil, err
+		}
+		return NewPeriod(start, d.addTo(start, 1))
+
+	default:
+		start, err := parseISOTime(startText)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseISOTime(endText)
+		if err != nil {
+			return nil, err
+		}
+		return NewPeriod(start, end)
+	}
+}
+
+// String formats the period as an ISO 8601 start/end interval.
+// Periods bounded by UTC midnights are written as dates only, and open bounds as '..'.
+func (p Period) String() string {
+	layout := time.RFC3339Nano
+	if (!p.HasStart() || isUTCDate(p.Start)) && (!p.HasEnd() || isUTCDate(p.End)) {
+		layout = dateLayout
+	}
+
+	start, end := openBound, openBound
+	if p.HasStart() {
+		start = p.Start.Format(layout)
+	}
+	if p.HasEnd() {
+		end = p.End.Format(layout)
+	}
+
+	return start + "/" + end
+}
+
+// MarshalText implements encoding.TextMarshaler using ISO 8601 interval notation.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ISO 8601 interval notation.
+func (p *Period) UnmarshalText(text []byte) error {
+	period, err := ParsePeriod(string(text))
+	if err != nil {
+		return err
+	}
+	*p = *period
+	return nil
+}
+
+// openBound is the ISO 8601-2 notation of a missing start or end.
+const openBound = ".."
+
+func parseOpenPeriod(s string, startText string, endText string) (*Period, error) {
+	start, end := negativeInfinity, positiveInfinity
+
+	var err error
+	if startText != openBound {
+		if start, err = parseISOTime(startText); err != nil {
+			return nil, fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+	}
+	if endText != openBound {
+		if end, err = parseISOTime(endText); err != nil {
+			return nil, fmt.Errorf("invalid interval %q: %w", s, err)
+		}
+	}
+
+	return NewPeriod(start, end)
+}
+
+func isUTCDate(t time.Time) bool {
+	return t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour))
+}
+
+func parseISOTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date or time %q", s)
+}
+
+// isoDuration is an ISO 8601 duration, keeping calendar parts apart from clock parts
+// so that P1M is one calendar month whatever the month length.
+type isoDuration struct {
+	years  int
+	months int
+	days   int
+	clock  time.Duration
+}
+
+// addTo moves t by the duration, forward when sign is 1 and backward when sign is -1.
+func (d isoDuration) addTo(t time.Time, sign int) time.Time {
+	return t.AddDate(sign*d.years, sign*d.months, sign*d.days).Add(time.Duration(sign) * d.clock)
+}
+
+// parseISODuration parses durations such as P1Y2M10DT2H30M, PT0.5S or P2W.
+func parseISODuration(s string) (isoDuration, error) {
+	var d isoDuration
+	invalid := fmt.Errorf("invalid duration %q", s)
+
+	rest, ok := strings.CutPrefix(s, "P")
+	if !ok || rest == "" {
+		return d, invalid
+	}
+
+	datePart, clockPart, hasClock := strings.Cut(rest, "T")
+	if hasClock && clockPart == "" {
+		return d, invalid
+	}
+
+	err := scanDurationParts(datePart, "YMWD", func(unit byte, value float64) error {
+		if value != float64(int(value)) {
+			return errors.New("fractional calendar units are not supported")
+		}
+		switch unit {
+		case 'Y':
+			d.years = int(value)
+		case 'M':
+			d.months = int(value)
+		case 'W':
+			d.days += 7 * int(value)
+		case 'D':
+			d.days += int(value)
+		}
+		return nil
+	})
+	if err != nil {
+		return d, fmt.Errorf("%w: %w", invalid, err)
+	}
+
+	err = scanDurationParts(clockPart, "HMS", func(unit byte, value float64) error {
+		switch unit {
+		case 'H':
+			d.clock += time.Duration(value * float64(time.Hour))
+		case 'M':
+			d.clock += time.Duration(value * float64(time.Minute))
+		case 'S':
+			d.clock += time.Duration(value * float64(time.Second))
+		}
+		return nil
+	})
+	if err != nil {
+		return d, fmt.Errorf("%w: %w", invalid, err)
+	}
+
+	return d, nil
+}
+
+// scanDurationParts reads number/unit pairs, with units appearing at most once and in given order.
+func scanDurationParts(s string, units string, f func(unit byte, value float64) error) error {
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if i <= 0 {
+			return errors.New("expected a number followed by a unit")
+		}
+
+		value, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil {
+			return err
+		}
+
+		pos := strings.IndexByte(units, s[i])
+		if pos < 0 {
+			return fmt.Errorf("unexpected unit %q", s[i])
+		}
+		if err := f(s[i], value); err != nil {
+			return err
+		}
+
+		units = units[pos+1:]
+		s = s[i+1:]
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected Period
+	}{
+		{
+			name:     "start and end dates",
+			text:     "2024-01-01/2024-02-01",
+			expected: Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)},
+		},
+		{
+			name:     "start and one month",
+			text:     "2024-01-31/P1M",
+			expected: Period{Start: DateOnly(2024, 1, 31), End: DateOnly(2024, 3, 2)},
+		},
+		{
+			name:     "two weeks and end",
+			text:     "P2W/2024-03-01",
+			expected: Period{Start: DateOnly(2024, 2, 16), End: DateOnly(2024, 3, 1)},
+		},
+		{
+			name: "date times with offset",
+			text: "2024-01-01T08:00:00+01:00/PT1H30M",
+			expected: Period{
+				Start: time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC),
+				End:   time.Date(2024, 1, 1, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "full duration",
+			text:     "2024-01-01/P1Y2M3DT4H",
+			expected: Period{Start: DateOnly(2024, 1, 1), End: time.Date(2025, 3, 4, 4, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := ParsePeriod(tt.text)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !period.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, *period)
+			}
+		})
+	}
+}
+
+func TestParsePeriod_Invalid(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"2024-01-01",
+		"2024-01-01/2024-02-01/2024-03-01",
+		"P1M/P1D",
+		"2024-02-01/2024-01-01",
+		"2024-01-01/P",
+		"2024-01-01/PT",
+		"2024-01-01/P1D2Y",
+		"2024-01-01/P1.5M",
+		"not a date/P1D",
+	} {
+		if _, err := ParsePeriod(text); err == nil {
+			t.Errorf("Expected an error for %q", text)
+		}
+	}
+}
+
+func TestPeriod_String(t *testing.T) {
+	january, _ := Month(2024, 1)
+	if january.String() != "2024-01-01/2024-02-01" {
+		t.Errorf("Expected 2024-01-01/2024-02-01, got %v", january.String())
+	}
+
+	morning, _ := NewPeriod(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	if morning.String() != "2024-01-01T08:00:00Z/2024-01-01T12:00:00Z" {
+		t.Errorf("Expected 2024-01-01T08:00:00Z/2024-01-01T12:00:00Z, got %v", morning.String())
+	}
+}
+
+func TestPeriod_TextRoundTrip(t *testing.T) {
+	paris := loadParis(t)
+	march, _ := MonthIn(2024, 3, paris)
+
+	type budget struct {
+		Period Period
+		Amount float64
+	}
+
+	data, err := json.Marshal(budget{Period: *march, Amount: 300})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := `{"Period":"2024-03-01T00:00:00+01:00/2024-04-01T00:00:00+02:00","Amount":300}`
+	if string(data) != expected {
+		t.Errorf("Expected %v, got %v", expected, string(data))
+	}
+
+	var decoded budget
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !decoded.Period.Equal(*march) {
+		t.Errorf("Expected %v, got %v", *march, decoded.Period)
+	}
+}
+
+func TestPeriod_OpenBoundsText(t *testing.T) {
+	tests := map[string]Period{
+		"2024-03-01/..":                Since(DateOnly(2024, 3, 1)),
+		"../2024-03-01":                Until(DateOnly(2024, 3, 1)),
+		"../..":                        Always(),
+		"2024-03-01T08:00:00+01:00/..": Since(time.Date(2024, 3, 1, 7, 0, 0, 0, time.UTC)),
+	}
+
+	for text, expected := range tests {
+		period, err := ParsePeriod(text)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", text, err)
+			continue
+		}
+
+		if !period.Equal(expected) {
+			t.Errorf("Expected %q to be %v, got %v", text, expected, *period)
+		}
+	}
+
+	for _, period := range []Period{Since(DateOnly(2024, 3, 1)), Until(DateOnly(2024, 3, 1)), Always()} {
+		parsed, err := ParsePeriod(period.String())
+		if err != nil || !parsed.Equal(period) {
+			t.Errorf("Expected %v to round trip, got %v (%v)", period, parsed, err)
+		}
+	}
+
+	if _, err := ParsePeriod("../P1M"); err == nil {
+		t.Errorf("Expected an error for an open bound with a duration")
+	}
+}
@@ -50,16 +50,20 @@ func (p *PeriodValue[T]) Clamp(limit Period) (PeriodValue[T], error) {
 // SplitAllPeriods get all periods of PeriodValue list
 func SplitAllPeriods[T any](periodValues []PeriodValue[T]) []Period {
 	var result []Period
-	timeMap := make(map[time.Time]struct{}, len(periodValues)*2)
+	// Key by UTC instant so identical instants in different locations are merged.
+	timeMap := make(map[time.Time]time.Time, len(periodValues)*2)
 
 	for _, pv := range periodValues {
-		timeMap[pv.Period.Start] = struct{}{}
-		timeMap[pv.Period.End] = struct{}{}
+		for _, t := range []time.Time{pv.Period.Start, pv.Period.End} {
+			if _, ok := timeMap[t.UTC()]; !ok {
+				timeMap[t.UTC()] = t
+			}
+		}
 	}
 
-	// Extract keys (times) and ensure order
+	// Extract times and ensure order
 	allTimes := make([]time.Time, 0, len(timeMap))
-	for t := range timeMap {
+	for _, t := range timeMap {
 		allTimes = append(allTimes, t)
 	}
 	sort.Slice(allTimes, func(i, j int) bool {
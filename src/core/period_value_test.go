@@ -15,3 +15,23 @@ func TestNewPeriodValue(t *testing.T) {
 		t.Errorf("period value: got %v, want %v", periodValue.Value, 45)
 	}
 }
+
+func TestSplitAllPeriods_ShouldMergeSameInstantsInDifferentLocations(t *testing.T) {
+	paris := loadParis(t)
+
+	utc, _ := NewPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 3))
+	local, _ := NewPeriod(DateOnly(2024, 1, 2).In(paris), DateOnly(2024, 1, 3).In(paris))
+
+	periods := SplitAllPeriods([]PeriodValue[int]{
+		NewPeriodValue(*utc, 1),
+		NewPeriodValue(*local, 2),
+	})
+
+	if len(periods) != 2 {
+		t.Fatalf("expected 2 periods, got %v", len(periods))
+	}
+
+	if !periods[1].Start.Equal(DateOnly(2024, 1, 2)) || !periods[1].End.Equal(DateOnly(2024, 1, 3)) {
+		t.Errorf("expected second period to be 2024-01-02 - 2024-01-03, got %v", periods[1])
+	}
+}
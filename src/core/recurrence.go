@@ -0,0 +1,550 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a recurrence rule.
+type Frequency int
+
+const (
+	FreqDaily Frequency = iota
+	FreqWeekly
+	FreqMonthly
+	FreqYearly
+)
+
+// WeekdayNum is a BYDAY entry: a weekday, optionally with its position in the month or year.
+// N is 0 for every such weekday, positive from the start and negative from the end (-1FR is the last friday).
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// RRule is a RFC 5545 recurrence rule.
+// Supported parts are FREQ (DAILY to YEARLY), INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH, BYSETPOS and WKST.
+type RRule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+	WeekStart  time.Weekday
+
+	// untilIsDate is set when UNTIL is a date: occurrences are then compared by date.
+	untilIsDate bool
+}
+
+var frequencies = map[string]Frequency{"DAILY": FreqDaily, "WEEKLY": FreqWeekly, "MONTHLY": FreqMonthly, "YEARLY": FreqYearly}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+// ParseRRule parses a recurrence rule such as FREQ=MONTHLY;BYMONTHDAY=5, with or without the RRULE: prefix.
+func ParseRRule(s string) (*RRule, error) {
+	rule := RRule{Interval: 1, WeekStart: time.Monday}
+	hasFreq := false
+
+	for _, part := range strings.Split(strings.TrimPrefix(s, "RRULE:"), ";") {
+		name, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+
+		var err error
+		switch name {
+		case "FREQ":
+			rule.Freq, hasFreq = frequencies[value]
+			if !hasFreq {
+				err = fmt.Errorf("unsupported frequency %q", value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(value)
+			if err == nil && rule.Interval < 1 {
+				err = errors.New("interval must be positive")
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(value)
+			if err == nil && rule.Count < 1 {
+				err = errors.New("count must be positive")
+			}
+		case "UNTIL":
+			rule.Until, rule.untilIsDate, err = parseICalTime(value, time.UTC)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(value, -31, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseIntList(value, 1, 12)
+			for _, m := range months {
+				rule.ByMonth = append(rule.ByMonth, time.Month(m))
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseIntList(value, -366, 366)
+		case "WKST":
+			var ok bool
+			if rule.WeekStart, ok = weekdays[value]; !ok {
+				err = fmt.Errorf("invalid weekday %q", value)
+			}
+		default:
+			err = errors.New("unsupported rule part")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule part %q: %w", part, err)
+		}
+	}
+
+	if !hasFreq {
+		return nil, errors.New("recurrence rule must have a FREQ")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return nil, errors.New("recurrence rule cannot have both COUNT and UNTIL")
+	}
+
+	return &rule, nil
+}
+
+// Recurrence is a set of occurrences generated by a rule from a start date (DTSTART),
+// minus exclusion dates (EXDATE). Each occurrence lasts a given duration, one day by default.
+// Holidays and weekends can be skipped using a BusinessCalendar.
+type Recurrence struct {
+	Start   time.Time
+	Rule    RRule
+	ExDates []time.Time
+
+	duration isoDuration
+	calendar *BusinessCalendar
+}
+
+// NewRecurrence creates a Recurrence from a start date and a rule such as FREQ=MONTHLY;BYMONTHDAY=5.
+// Occurrences keep the clock time and location of start.
+func NewRecurrence(start time.Time, rule string) (*Recurrence, error) {
+	r, err := ParseRRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	return &Recurrence{Start: start, Rule: *r, duration: isoDuration{days: 1}}, nil
+}
+
+// ParseRecurrence parses iCalendar DTSTART, RRULE, EXDATE and DURATION lines, such as:
+//
+//	DTSTART;TZID=Europe/Paris:20240105T090000
+//	RRULE:FREQ=MONTHLY;BYMONTHDAY=5
+//	EXDATE:20240805
+//	DURATION:P1D
+func ParseRecurrence(text string) (*Recurrence, error) {
+	r := Recurrence{duration: isoDuration{days: 1}}
+	var rule *RRule
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		head, value, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid line %q", line)
+		}
+		name, params, _ := strings.Cut(head, ";")
+
+		loc := time.UTC
+		if tzid, ok := strings.CutPrefix(params, "TZID="); ok {
+			var err error
+			if loc, err = time.LoadLocation(tzid); err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		switch name {
+		case "DTSTART":
+			r.Start, _, err = parseICalTime(value, loc)
+		case "RRULE":
+			rule, err = ParseRRule(value)
+		case "EXDATE":
+			for _, v := range strings.Split(value, ",") {
+				var exDate time.Time
+				if exDate, _, err = parseICalTime(v, loc); err != nil {
+					break
+				}
+				r.ExDates = append(r.ExDates, exDate)
+			}
+		case "DURATION":
+			r.duration, err = parseISODuration(value)
+		default:
+			err = errors.New("unsupported property")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid line %q: %w", line, err)
+		}
+	}
+
+	if r.Start.IsZero() || rule == nil {
+		return nil, errors.New("recurrence must have a DTSTART and a RRULE")
+	}
+	r.Rule = *rule
+
+	return &r, nil
+}
+
+// SetDuration sets the ISO 8601 duration of each occurrence, such as P1D or PT2H.
+func (r *Recurrence) SetDuration(duration string) error {
+	d, err := parseISODuration(duration)
+	if err != nil {
+		return err
+	}
+	r.duration = d
+	return nil
+}
+
+// OnBusinessDays keeps only candidate days that are business days of given calendar.
+// The filter applies before BYSETPOS, so BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1 is then the last business day.
+func (r *Recurrence) OnBusinessDays(cal *BusinessCalendar) *Recurrence {
+	r.calendar = cal
+	return r
+}
+
+// Occurrences returns the start of each occurrence within given window.
+// COUNT is applied from the recurrence start, whatever the window; an exclusion date at midnight excludes the whole day.
+// The window must have an end unless the rule has a COUNT or an UNTIL, or occurrences would never end.
+// Occurrences also end after a whole 400-year gregorian cycle of frames without candidate day, such as
+// for BYMONTH=2;BYMONTHDAY=31 or a calendar closing every day, which would never match.
+func (r *Recurrence) Occurrences(window Period) (iter.Seq[time.Time], error) {
+	if !window.HasEnd() && r.Rule.Count == 0 && r.Rule.Until.IsZero() {
+		return nil, errors.New("recurrence without COUNT or UNTIL needs a window with an end")
+	}
+
+	return func(yield func(time.Time) bool) {
+		count := 0
+		empty := 0
+
+		for i := 0; ; i++ {
+			frame, days := r.expand(i)
+			if !frame.Before(window.End) || r.afterUntil(frame) {
+				return
+			}
+
+			if len(days) > 0 {
+				empty = 0
+			} else if empty++; empty >= r.cycleFrames() {
+				return
+			}
+
+			for _, day := range days {
+				occurrence := r.at(day)
+				if occurrence.Before(r.Start) {
+					continue
+				}
+				if r.afterUntil(occurrence) || (r.Rule.Count > 0 && count >= r.Rule.Count) || !occurrence.Before(window.End) {
+					return
+				}
+				count++
+
+				if r.isExcluded(occurrence) || occurrence.Before(window.Start) {
+					continue
+				}
+				if !yield(occurrence) {
+					return
+				}
+			}
+		}
+	}, nil
+}
+
+// Periods returns a Period, lasting the recurrence duration, for each occurrence within given window.
+// The window must have an end unless the rule has a COUNT or an UNTIL.
+func (r *Recurrence) Periods(window Period) (iter.Seq[Period], error) {
+	occurrences, err := r.Occurrences(window)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(Period) bool) {
+		for occurrence := range occurrences {
+			if !yield(Period{Start: occurrence, End: r.duration.addTo(occurrence, 1)}) {
+				return
+			}
+		}
+	}, nil
+}
+
+// cycleFrames returns the number of frames of the frequency in a 400-year gregorian cycle, after which
+// candidate days repeat: a rule without candidate day during that many consecutive frames has none.
+func (r *Recurrence) cycleFrames() int {
+	switch r.Rule.Freq {
+	case FreqDaily:
+		return 146097
+	case FreqWeekly:
+		return 20871
+	case FreqMonthly:
+		return 4800
+	default:
+		return 400
+	}
+}
+
+// expand returns the start of the i-th frame (year, month, week or day depending on frequency)
+// and its candidate days, at UTC midnight, sorted and filtered by BYSETPOS.
+func (r *Recurrence) expand(i int) (time.Time, []time.Time) {
+	rule := r.Rule
+	step := i * max(rule.Interval, 1)
+	y, m, d := r.Start.Date()
+
+	var frame time.Time
+	var days []time.Time
+
+	switch rule.Freq {
+	case FreqDaily:
+		frame = time.Date(y, m, d+step, 0, 0, 0, 0, time.UTC)
+		if r.matchesMonth(frame) && r.matchesMonthDay(frame) && r.matchesWeekday(frame) {
+			days = []time.Time{frame}
+		}
+
+	case FreqWeekly:
+		start := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		offset := (int(start.Weekday()) - int(rule.WeekStart) + 7) % 7
+		frame = start.AddDate(0, 0, 7*step-offset)
+		for k := 0; k < 7; k++ {
+			day := frame.AddDate(0, 0, k)
+			matches := day.Weekday() == r.Start.Weekday()
+			if len(rule.ByDay) > 0 {
+				matches = r.matchesWeekday(day)
+			}
+			if matches && r.matchesMonth(day) {
+				days = append(days, day)
+			}
+		}
+
+	case FreqMonthly:
+		frame = time.Date(y, m+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		if r.matchesMonth(frame) {
+			days = r.daysOfMonth(frame)
+		}
+
+	case FreqYearly:
+		frame = time.Date(y+step, 1, 1, 0, 0, 0, 0, time.UTC)
+		switch {
+		case len(rule.ByMonth) > 0 || len(rule.ByMonthDay) > 0:
+			for month := time.January; month <= time.December; month++ {
+				start := time.Date(frame.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+				if r.matchesMonth(start) {
+					days = append(days, r.daysOfMonth(start)...)
+				}
+			}
+		case len(rule.ByDay) > 0:
+			days = r.weekdaysIn(frame, frame.AddDate(1, 0, 0))
+		default:
+			if day := time.Date(frame.Year(), m, d, 0, 0, 0, 0, time.UTC); day.Day() == d {
+				days = []time.Time{day}
+			}
+		}
+	}
+
+	if r.calendar != nil {
+		days = slices.DeleteFunc(days, func(day time.Time) bool { return !r.calendar.IsBusinessDay(r.at(day)) })
+	}
+
+	// frame is compared to the window and UNTIL, so it holds the clock time of the start too
+	return r.at(frame), r.selectSetPos(days)
+}
+
+// daysOfMonth returns candidate days of the month starting on given day.
+func (r *Recurrence) daysOfMonth(start time.Time) []time.Time {
+	rule := r.Rule
+	end := start.AddDate(0, 1, 0)
+
+	if len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+		d := r.Start.Day()
+		if day := start.AddDate(0, 0, d-1); day.Before(end) {
+			return []time.Time{day}
+		}
+		return nil
+	}
+
+	var days []time.Time
+	if len(rule.ByDay) > 0 {
+		days = r.weekdaysIn(start, end)
+	} else {
+		for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+			days = append(days, day)
+		}
+	}
+
+	return slices.DeleteFunc(days, func(day time.Time) bool { return !r.matchesMonthDay(day) })
+}
+
+// weekdaysIn returns the days in [start, end) matching BYDAY, with positions relative to that range.
+func (r *Recurrence) weekdaysIn(start time.Time, end time.Time) []time.Time {
+	var days []time.Time
+
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, wd := range r.Rule.ByDay {
+			if day.Weekday() != wd.Weekday {
+				continue
+			}
+
+			position := int(day.Sub(start).Hours()/24)/7 + 1
+			fromEnd := -(int(end.Sub(day).Hours()/24)-1)/7 - 1
+			if wd.N == 0 || wd.N == position || wd.N == fromEnd {
+				days = append(days, day)
+				break
+			}
+		}
+	}
+
+	return days
+}
+
+func (r *Recurrence) selectSetPos(days []time.Time) []time.Time {
+	if len(r.Rule.BySetPos) == 0 {
+		return days
+	}
+
+	var selected []time.Time
+	for _, pos := range r.Rule.BySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(days) + pos
+		}
+		if i >= 0 && i < len(days) && !slices.Contains(selected, days[i]) {
+			selected = append(selected, days[i])
+		}
+	}
+
+	slices.SortFunc(selected, time.Time.Compare)
+	return selected
+}
+
+func (r *Recurrence) matchesMonth(day time.Time) bool {
+	return len(r.Rule.ByMonth) == 0 || slices.Contains(r.Rule.ByMonth, day.Month())
+}
+
+func (r *Recurrence) matchesMonthDay(day time.Time) bool {
+	if len(r.Rule.ByMonthDay) == 0 {
+		return true
+	}
+
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, md := range r.Rule.ByMonthDay {
+		if md == day.Day() || daysInMonth+md+1 == day.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchesWeekday(day time.Time) bool {
+	if len(r.Rule.ByDay) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(r.Rule.ByDay, func(wd WeekdayNum) bool { return wd.Weekday == day.Weekday() })
+}
+
+// at returns given day at the clock time and location of the recurrence start.
+func (r *Recurrence) at(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(),
+		r.Start.Hour(), r.Start.Minute(), r.Start.Second(), r.Start.Nanosecond(), r.Start.Location())
+}
+
+func (r *Recurrence) afterUntil(t time.Time) bool {
+	until := r.Rule.Until
+	if until.IsZero() {
+		return false
+	}
+
+	if r.Rule.untilIsDate {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).After(until)
+	}
+	return t.After(until)
+}
+
+func (r *Recurrence) isExcluded(t time.Time) bool {
+	for _, exDate := range r.ExDates {
+		if exDate.Equal(t) {
+			return true
+		}
+
+		local := exDate.In(t.Location())
+		if local.Hour() == 0 && local.Minute() == 0 && local.Second() == 0 && local.Nanosecond() == 0 {
+			y1, m1, d1 := local.Date()
+			y2, m2, d2 := t.Date()
+			if y1 == y2 && m1 == m2 && d1 == d2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseICalTime parses iCalendar DATE (20240105) and DATE-TIME (20240105T090000, 20240105T090000Z) values.
+// Values without Z are read in given location.
+func parseICalTime(s string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("20060102", s, loc); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse("20060102T150405Z", s); err == nil {
+		return t, false, nil
+	}
+	t, err := time.ParseInLocation("20060102T150405", s, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date or time %q", s)
+	}
+	return t, false, nil
+}
+
+func parseByDay(s string) ([]WeekdayNum, error) {
+	var result []WeekdayNum
+
+	for _, v := range strings.Split(s, ",") {
+		if len(v) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", v)
+		}
+
+		wd, ok := weekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", v)
+		}
+
+		n := 0
+		if prefix := v[:len(v)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid weekday %q", v)
+			}
+		}
+
+		result = append(result, WeekdayNum{N: n, Weekday: wd})
+	}
+
+	return result, nil
+}
+
+func parseIntList(s string, lowest int, highest int) ([]int, error) {
+	var result []int
+
+	for _, v := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(v)
+		if err != nil || n == 0 || n < lowest || n > highest {
+			return nil, fmt.Errorf("invalid value %q", v)
+		}
+		result = append(result, n)
+	}
+
+	return result, nil
+}
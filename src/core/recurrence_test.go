@@ -0,0 +1,233 @@
+package core
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func collectDates(t *testing.T, r *Recurrence, window Period) []string {
+	t.Helper()
+	occurrences, err := r.Occurrences(window)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var dates []string
+	for occurrence := range occurrences {
+		dates = append(dates, occurrence.Format(dateLayout))
+	}
+	return dates
+}
+
+func TestRecurrence_Occurrences(t *testing.T) {
+	year, _ := Year(2024)
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		rule     string
+		window   Period
+		expected []string
+	}{
+		{
+			name:     "monthly on the 5th",
+			start:    DateOnly(2024, 1, 5),
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=4",
+			window:   *year,
+			expected: []string{"2024-01-05", "2024-02-05", "2024-03-05", "2024-04-05"},
+		},
+		{
+			name:     "every other friday",
+			start:    DateOnly(2024, 1, 5),
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR;UNTIL=20240301",
+			window:   *year,
+			expected: []string{"2024-01-05", "2024-01-19", "2024-02-02", "2024-02-16", "2024-03-01"},
+		},
+		{
+			name:     "last weekday of each quarter",
+			start:    DateOnly(2024, 1, 1),
+			rule:     "FREQ=MONTHLY;BYMONTH=3,6,9,12;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			window:   *year,
+			expected: []string{"2024-03-29", "2024-06-28", "2024-09-30", "2024-12-31"},
+		},
+		{
+			name:     "last day of month",
+			start:    DateOnly(2024, 1, 31),
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			window:   *year,
+			expected: []string{"2024-01-31", "2024-02-29", "2024-03-31"},
+		},
+		{
+			name:     "monthly on the 31st skips short months",
+			start:    DateOnly(2024, 1, 31),
+			rule:     "FREQ=MONTHLY;COUNT=3",
+			window:   *year,
+			expected: []string{"2024-01-31", "2024-03-31", "2024-05-31"},
+		},
+		{
+			name:     "second monday of january and june",
+			start:    DateOnly(2024, 1, 1),
+			rule:     "FREQ=YEARLY;BYMONTH=1,6;BYDAY=2MO;COUNT=3",
+			window:   Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2026, 1, 1)},
+			expected: []string{"2024-01-08", "2024-06-10", "2025-01-13"},
+		},
+		{
+			name:     "last sunday of the year",
+			start:    DateOnly(2024, 1, 1),
+			rule:     "FREQ=YEARLY;BYDAY=-1SU",
+			window:   Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2026, 1, 1)},
+			expected: []string{"2024-12-29", "2025-12-28"},
+		},
+		{
+			name:     "daily on week days in window",
+			start:    DateOnly(2024, 1, 1),
+			rule:     "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			window:   Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 3, 6)},
+			expected: []string{"2024-03-01", "2024-03-04", "2024-03-05"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRecurrence(tt.start, tt.rule)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if dates := collectDates(t, r, tt.window); !slices.Equal(dates, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, dates)
+			}
+		})
+	}
+}
+
+func TestRecurrence_CountShouldApplyFromStart(t *testing.T) {
+	r, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=MONTHLY;COUNT=4")
+	window := Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2025, 1, 1)}
+
+	if dates := collectDates(t, r, window); !slices.Equal(dates, []string{"2024-03-05", "2024-04-05"}) {
+		t.Errorf("Expected [2024-03-05 2024-04-05], got %v", dates)
+	}
+}
+
+func TestParseRecurrence(t *testing.T) {
+	paris := loadParis(t)
+
+	r, err := ParseRecurrence(`DTSTART;TZID=Europe/Paris:20240105T090000
+RRULE:FREQ=MONTHLY;BYMONTHDAY=5;COUNT=4
+EXDATE;TZID=Europe/Paris:20240205T090000,20240405
+DURATION:PT2H`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	year, _ := YearIn(2024, paris)
+	occurrences, err := r.Periods(*year)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	periods := slices.Collect(occurrences)
+
+	expected := []Period{
+		{Start: time.Date(2024, 1, 5, 9, 0, 0, 0, paris), End: time.Date(2024, 1, 5, 11, 0, 0, 0, paris)},
+		{Start: time.Date(2024, 3, 5, 9, 0, 0, 0, paris), End: time.Date(2024, 3, 5, 11, 0, 0, 0, paris)},
+	}
+	if !slices.EqualFunc(periods, expected, Period.Equal) {
+		t.Errorf("Expected %v, got %v", expected, periods)
+	}
+}
+
+func TestParseRRule_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"BYDAY=MO",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240101",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYHOUR=9",
+	} {
+		if _, err := ParseRRule(rule); err == nil {
+			t.Errorf("Expected an error for %q", rule)
+		}
+	}
+}
+
+func TestRecurrence_OnBusinessDays(t *testing.T) {
+	cal := NewBusinessCalendar(time.UTC).AddHolidays(FixedHoliday{Month: time.December, Day: 31})
+
+	r, _ := NewRecurrence(DateOnly(2024, 1, 1), "FREQ=MONTHLY;BYMONTH=3,6,9,12;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+	r.OnBusinessDays(cal)
+
+	year, _ := Year(2024)
+	dates := collectDates(t, r, *year)
+
+	if dates[3] != "2024-12-30" {
+		t.Errorf("Expected last business day of Q4 to be 2024-12-30, got %v", dates[3])
+	}
+}
+
+func TestTimeLineBuilder_AddRecurrence(t *testing.T) {
+	r, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=MONTHLY")
+	q1, _ := Quarter(2024, 1)
+
+	timeline, err := NewTimeLineBuilder[int]().AddRecurrence(r, *q1, 300).Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	if len(timeline.Items) != 3 {
+		t.Fatalf("Expected 3 items, got %d", len(timeline.Items))
+	}
+
+	day, _ := Day(2024, 3, 5)
+	if !timeline.Items[2].Period.Equal(*day) || timeline.Items[2].Value != 300 {
+		t.Errorf("Expected 2024-03-05 to be 300, got %v", timeline.Items[2])
+	}
+}
+
+func TestRecurrence_ShouldRejectEndlessOccurrences(t *testing.T) {
+	since := Since(DateOnly(2024, 1, 1))
+
+	endless, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=MONTHLY")
+	if _, err := endless.Occurrences(since); err == nil {
+		t.Errorf("Expected an error for a rule without end over an unbounded window")
+	}
+	if _, err := NewTimeLineBuilder[int]().AddRecurrence(endless, since, 300).Build(); err == nil {
+		t.Errorf("Expected the builder to keep the error")
+	}
+
+	counted, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=MONTHLY;COUNT=3")
+	timeline, err := NewTimeLineBuilder[int]().AddRecurrence(counted, since, 300).Build()
+	if err != nil || len(timeline.Items) != 3 {
+		t.Errorf("Expected 3 items, got %v (%v)", timeline.Items, err)
+	}
+
+	until, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=MONTHLY;UNTIL=20240601")
+	if dates := collectDates(t, until, since); len(dates) != 5 {
+		t.Errorf("Expected 5 occurrences, got %v", dates)
+	}
+}
+
+func TestRecurrence_ShouldEndWithoutCandidateDays(t *testing.T) {
+	since := Since(DateOnly(2024, 1, 1))
+
+	never, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=MONTHLY;BYMONTH=2;BYMONTHDAY=31;COUNT=1")
+	if dates := collectDates(t, never, since); len(dates) != 0 {
+		t.Errorf("Expected no occurrence, got %v", dates)
+	}
+
+	closed, _ := NewRecurrence(DateOnly(2024, 1, 5), "FREQ=DAILY;COUNT=3")
+	closed.OnBusinessDays(NewBusinessCalendar(time.UTC).AddClosure(since))
+	if _, err := NewTimeLineBuilder[int]().AddRecurrence(closed, since, 300).Build(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// the 29th of February only comes every 4 years
+	leap, _ := NewRecurrence(DateOnly(2024, 3, 1), "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;COUNT=2")
+	if dates := collectDates(t, leap, since); !slices.Equal(dates, []string{"2028-02-29", "2032-02-29"}) {
+		t.Errorf("Expected the next two leap days, got %v", dates)
+	}
+}
@@ -0,0 +1,101 @@
+package core
+
+// Relation is one of the 13 relations of Allen's interval algebra between two periods.
+// Periods are end-exclusive: a period ending when another one starts meets it, without overlapping.
+type Relation int
+
+const (
+	// RelationPrecedes means the period ends before the other one starts.
+	RelationPrecedes Relation = iota
+	// RelationMeets means the period ends when the other one starts.
+	RelationMeets
+	// RelationOverlaps means the period starts first and ends while the other one goes on.
+	RelationOverlaps
+	// RelationStarts means both periods start together and the period ends first.
+	RelationStarts
+	// RelationDuring means the period starts after and ends before the other one.
+	RelationDuring
+	// RelationFinishes means both periods end together and the period starts last.
+	RelationFinishes
+	// RelationEquals means both periods have same start and end.
+	RelationEquals
+	// RelationFinishedBy is the inverse of RelationFinishes.
+	RelationFinishedBy
+	// RelationContains is the inverse of RelationDuring.
+	RelationContains
+	// RelationStartedBy is the inverse of RelationStarts.
+	RelationStartedBy
+	// RelationOverlappedBy is the inverse of RelationOverlaps.
+	RelationOverlappedBy
+	// RelationMetBy is the inverse of RelationMeets.
+	RelationMetBy
+	// RelationPrecededBy is the inverse of RelationPrecedes.
+	RelationPrecededBy
+)
+
+var relationNames = [...]string{
+	"precedes", "meets", "overlaps", "starts", "during", "finishes", "equals",
+	"finished by", "contains", "started by", "overlapped by", "met by", "preceded by",
+}
+
+// String returns the name of the relation.
+func (r Relation) String() string {
+	if r < RelationPrecedes || r > RelationPrecededBy {
+		return "unknown"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation of the other period to the period.
+func (r Relation) Inverse() Relation {
+	return RelationPrecededBy - r
+}
+
+// Relation returns the Allen relation of the period to the other one.
+func (p *Period) Relation(other Period) Relation {
+	switch {
+	case p.End.Before(other.Start):
+		return RelationPrecedes
+	case p.End.Equal(other.Start):
+		return RelationMeets
+	case p.Start.After(other.End):
+		return RelationPrecededBy
+	case p.Start.Equal(other.End):
+		return RelationMetBy
+	}
+
+	sameStart := p.Start.Equal(other.Start)
+	sameEnd := p.End.Equal(other.End)
+
+	switch {
+	case sameStart && sameEnd:
+		return RelationEquals
+	case sameStart && p.End.Before(other.End):
+		return RelationStarts
+	case sameStart:
+		return RelationStartedBy
+	case sameEnd && p.Start.After(other.Start):
+		return RelationFinishes
+	case sameEnd:
+		return RelationFinishedBy
+	case p.Start.After(other.Start) && p.End.Before(other.End):
+		return RelationDuring
+	case p.Start.Before(other.Start) && p.End.After(other.End):
+		return RelationContains
+	case p.Start.Before(other.Start):
+		return RelationOverlaps
+	default:
+		return RelationOverlappedBy
+	}
+}
+
+// Is checks if the relation of the period to the other one is one of given relations.
+func (p *Period) Is(other Period, relations ...Relation) bool {
+	r := p.Relation(other)
+	for _, candidate := range relations {
+		if r == candidate {
+			return true
+		}
+	}
+	return false
+}
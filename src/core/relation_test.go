@@ -0,0 +1,104 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestPeriod_Relation(t *testing.T) {
+	reference := januaryDays(10, 20)
+
+	tests := []struct {
+		period   Period
+		expected Relation
+	}{
+		{januaryDays(1, 5), RelationPrecedes},
+		{januaryDays(1, 10), RelationMeets},
+		{januaryDays(5, 15), RelationOverlaps},
+		{januaryDays(10, 15), RelationStarts},
+		{januaryDays(12, 18), RelationDuring},
+		{januaryDays(15, 20), RelationFinishes},
+		{januaryDays(10, 20), RelationEquals},
+		{januaryDays(5, 20), RelationFinishedBy},
+		{januaryDays(5, 25), RelationContains},
+		{januaryDays(10, 25), RelationStartedBy},
+		{januaryDays(15, 25), RelationOverlappedBy},
+		{januaryDays(20, 25), RelationMetBy},
+		{januaryDays(25, 30), RelationPrecededBy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			if r := tt.period.Relation(reference); r != tt.expected {
+				t.Errorf("Expected %v %v %v, got %v", tt.period, tt.expected, reference, r)
+			}
+
+			if r := reference.Relation(tt.period); r != tt.expected.Inverse() {
+				t.Errorf("Expected %v %v %v, got %v", reference, tt.expected.Inverse(), tt.period, r)
+			}
+		})
+	}
+}
+
+func TestPeriod_PredicatesFollowRelations(t *testing.T) {
+	reference := januaryDays(10, 20)
+
+	tests := []struct {
+		relation       Relation
+		period         Period
+		intersects     bool
+		containsPeriod bool
+		before         bool
+		after          bool
+		contiguous     bool
+	}{
+		{RelationPrecedes, januaryDays(1, 5), false, false, false, true, false},
+		{RelationMeets, januaryDays(1, 10), false, false, false, true, true},
+		{RelationOverlaps, januaryDays(5, 15), true, false, false, false, false},
+		{RelationStarts, januaryDays(10, 15), true, true, false, false, false},
+		{RelationDuring, januaryDays(12, 18), true, true, false, false, false},
+		{RelationFinishes, januaryDays(15, 20), true, true, false, false, false},
+		{RelationEquals, januaryDays(10, 20), true, true, false, false, false},
+		{RelationFinishedBy, januaryDays(5, 20), true, false, false, false, false},
+		{RelationContains, januaryDays(5, 25), true, false, false, false, false},
+		{RelationStartedBy, januaryDays(10, 25), true, false, false, false, false},
+		{RelationOverlappedBy, januaryDays(15, 25), true, false, false, false, false},
+		{RelationMetBy, januaryDays(20, 25), false, false, true, false, true},
+		{RelationPrecededBy, januaryDays(25, 30), false, false, true, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.relation.String(), func(t *testing.T) {
+			if reference.Intersects(tt.period) != tt.intersects {
+				t.Errorf("Expected Intersects to be %v", tt.intersects)
+			}
+			if reference.ContainsPeriod(tt.period) != tt.containsPeriod {
+				t.Errorf("Expected ContainsPeriod to be %v", tt.containsPeriod)
+			}
+			if reference.Before(tt.period) != tt.before {
+				t.Errorf("Expected Before to be %v", tt.before)
+			}
+			if reference.After(tt.period) != tt.after {
+				t.Errorf("Expected After to be %v", tt.after)
+			}
+			if reference.IsContiguous(tt.period) != tt.contiguous {
+				t.Errorf("Expected IsContiguous to be %v", tt.contiguous)
+			}
+		})
+	}
+}
+
+func TestPeriod_Contains_ShouldExcludeEnd(t *testing.T) {
+	january, _ := Month(2024, 1)
+
+	if !january.Contains(january.Start) {
+		t.Errorf("Expected period to contain its start")
+	}
+
+	if january.Contains(january.End) {
+		t.Errorf("Expected period not to contain its end")
+	}
+
+	if january.Contains(DateOnly(2023, 12, 31)) {
+		t.Errorf("Expected period not to contain a time before its start")
+	}
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"errors"
+	"iter"
+	"time"
+)
+
+// Splitter cuts a period into consecutive periods, such as (*Period).SplitByMonths.
+type Splitter func(p *Period) iter.Seq[Period]
+
+// ResampleMode tells how the values of the items within a bucket give the value of the bucket.
+type ResampleMode int
+
+const (
+	// ResampleSumByDuration sums the values prorated by the duration of the item within the bucket.
+	ResampleSumByDuration ResampleMode = iota
+	// ResampleSumByDays sums the values prorated by the number of calendar days of the item within the bucket.
+	ResampleSumByDays
+	// ResampleMean averages the values weighted by their duration within the bucket.
+	ResampleMean
+	// ResampleFirst takes the value at the earliest time covered within the bucket.
+	ResampleFirst
+	// ResampleLast takes the value at the latest time covered within the bucket.
+	ResampleLast
+)
+
+// resamplePiece is the part of an item within a bucket.
+type resamplePiece[T Number] struct {
+	value T
+	item  Period
+}
+
+// Resample returns a Timeline with one item per bucket given by splitter over the span of t, such as
+// a monthly view of annual and quarterly amounts. Buckets covered by no item are skipped.
+// Where items overlap within a bucket, their values are folded by reducer in chronological order,
+// from the first one without zero value seed, after proration in sum modes. Integer values are rounded after each proration.
+func Resample[T Number](t *Timeline[T], splitter Splitter, mode ResampleMode, reducer func(p Period, a T, b T) T) (Timeline[T], error) {
+	items := []PeriodValue[T]{}
+	if len(t.Items) == 0 {
+		return Timeline[T]{Items: items}, nil
+	}
+	if err := t.checkSorted(); err != nil {
+		return Timeline[T]{}, err
+	}
+
+	span := Period{Start: t.Items[0].Period.Start, End: t.Items[0].Period.End}
+	for _, item := range t.Items {
+		span.End = maxTime(span.End, item.Period.End)
+	}
+	if !span.IsBounded() {
+		return Timeline[T]{}, errors.New("cannot resample an unbounded timeline")
+	}
+
+	index := t.queryIndex()
+	for bucket := range splitter(&span) {
+		pieces := NewTimeline[resamplePiece[T]]()
+		for _, item := range index.FindIntersects(bucket) {
+			piece := Period{Start: maxTime(item.Period.Start, bucket.Start), End: minTime(item.Period.End, bucket.End)}
+			pieces.Items = append(pieces.Items, NewPeriodValue(piece, resamplePiece[T]{value: item.Value, item: item.Period}))
+		}
+		if len(pieces.Items) == 0 {
+			continue
+		}
+
+		segments, err := ResolveConflictsAll(&pieces, func(p Period, covering []PeriodValue[resamplePiece[T]]) T {
+			// fold from the first share rather than the zero value, which would count as a value for min or max
+			value := covering[0].Value.share(p, mode)
+			for _, c := range covering[1:] {
+				value = reducer(p, c.Value.share(p, mode), value)
+			}
+			return value
+		})
+		if err != nil {
+			return Timeline[T]{}, err
+		}
+
+		items = append(items, NewPeriodValue(bucket, combineSegments(segments.Items, mode)))
+	}
+
+	return Timeline[T]{Items: items}, nil
+}
+
+// share returns the value of the piece for given part of it: the value prorated in sum modes,
+// the value itself otherwise.
+func (r resamplePiece[T]) share(part Period, mode ResampleMode) T {
+	switch mode {
+	case ResampleSumByDuration:
+		return fromFloat[T](float64(r.value) * float64(part.Duration()) / float64(r.item.Duration()))
+	case ResampleSumByDays:
+		// days are counted in the location of the item, so that the days of its parts add up
+		loc := r.item.Start.Location()
+		itemDays := calendarDays(r.item, loc)
+		if itemDays == 0 {
+			return fromFloat[T](float64(r.value) * float64(part.Duration()) / float64(r.item.Duration()))
+		}
+		return fromFloat[T](float64(r.value) * float64(calendarDays(part, loc)) / float64(itemDays))
+	default:
+		return r.value
+	}
+}
+
+func combineSegments[T Number](segments []PeriodValue[T], mode ResampleMode) T {
+	switch mode {
+	case ResampleFirst:
+		return segments[0].Value
+	case ResampleLast:
+		return segments[len(segments)-1].Value
+	case ResampleMean:
+		var weighted float64
+		var total time.Duration
+		for _, s := range segments {
+			weighted += float64(s.Value) * float64(s.Period.Duration())
+			total += s.Period.Duration()
+		}
+		return fromFloat[T](weighted / float64(total))
+	default:
+		var sum T
+		for _, s := range segments {
+			sum += s.Value
+		}
+		return sum
+	}
+}
+
+// calendarDays returns the number of midnights crossed by the period in given location.
+func calendarDays(p Period, loc *time.Location) int {
+	return DateOf(p.End.In(loc)).DaysSince(DateOf(p.Start.In(loc)))
+}
@@ -0,0 +1,126 @@
+package core
+
+import (
+	"iter"
+	"math"
+	"testing"
+)
+
+func sum[T Number](p Period, a T, b T) T {
+	return a + b
+}
+
+func TestResample_SumByDays(t *testing.T) {
+	premium, _ := NewTimeLineBuilder[float64]().
+		AddYear(2024, 1200).
+		Build()
+
+	monthly, err := Resample(&premium, (*Period).SplitByMonths, ResampleSumByDays, sum[float64])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(monthly.Items) != 12 {
+		t.Fatalf("Expected 12 months, got %v", monthly.Items)
+	}
+
+	february, _ := Month(2024, 2)
+	if !monthly.Items[1].Period.Equal(*february) || math.Abs(monthly.Items[1].Value-1200*29.0/366) > 1e-9 {
+		t.Errorf("Expected %v for february, got %v", 1200*29.0/366, monthly.Items[1])
+	}
+
+	total := Reduce(&monthly, 0.0, func(acc float64, item PeriodValue[float64]) float64 { return acc + item.Value })
+	if math.Abs(total-1200) > 1e-9 {
+		t.Errorf("Expected months to sum to 1200, got %v", total)
+	}
+}
+
+func TestResample_SumByDaysAndDuration_ShouldDifferOnDST(t *testing.T) {
+	paris := loadParis(t)
+	fee, _ := NewTimeLineBuilder[float64]().
+		AddPeriod(DateOnlyIn(2024, 3, 30, paris), DateOnlyIn(2024, 4, 1, paris), 48).
+		Build()
+
+	byDays := func(p *Period) iter.Seq[Period] { return p.SplitByDaysIn(paris) }
+
+	days, _ := Resample(&fee, byDays, ResampleSumByDays, sum[float64])
+	if days.Items[0].Value != 24 || days.Items[1].Value != 24 {
+		t.Errorf("Expected 24 per day, got %v", days.Items)
+	}
+
+	// the 31st of March lasts 23 hours
+	durations, _ := Resample(&fee, byDays, ResampleSumByDuration, sum[float64])
+	if durations.Items[0].Value != 48*24.0/47 || durations.Items[1].Value != 48*23.0/47 {
+		t.Errorf("Expected shares by hours, got %v", durations.Items)
+	}
+}
+
+func TestResample_ShouldSumSeveralItemsInBucket(t *testing.T) {
+	fees, _ := NewTimeLineBuilder[int]().
+		AddQuarter(2024, 1, 300).
+		AddMonth(2024, 2, 50).
+		AddMonth(2024, 2, 20).
+		Build()
+
+	monthly, _ := Resample(&fees, (*Period).SplitByMonths, ResampleSumByDays, sum[int])
+	// 300 * 29 / 91 is rounded to 96
+	if monthly.Items[0].Value != 102 || monthly.Items[1].Value != 166 || monthly.Items[2].Value != 102 {
+		t.Errorf("Expected [102 166 102], got %v", monthly.Items)
+	}
+
+	highest, _ := Resample(&fees, (*Period).SplitByMonths, ResampleSumByDays, func(p Period, a int, b int) int { return max(a, b) })
+	if highest.Items[1].Value != 96 {
+		t.Errorf("Expected 96 for february, got %v", highest.Items[1])
+	}
+}
+
+func TestResample_MeanFirstLast(t *testing.T) {
+	rates, _ := NewTimeLineBuilder[float64]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 11), 10).
+		AddPeriod(DateOnly(2024, 1, 11), DateOnly(2024, 2, 1), 20).
+		AddPeriod(DateOnly(2024, 3, 1), DateOnly(2024, 3, 2), 30).
+		Build()
+
+	tests := []struct {
+		mode     ResampleMode
+		expected float64
+	}{
+		{ResampleMean, (10*10 + 20*21) / 31.0},
+		{ResampleFirst, 10},
+		{ResampleLast, 20},
+	}
+
+	for _, tt := range tests {
+		monthly, _ := Resample(&rates, (*Period).SplitByMonths, tt.mode, sum[float64])
+
+		// february is not covered, march only starts
+		if len(monthly.Items) != 2 {
+			t.Fatalf("Expected 2 buckets, got %v", monthly.Items)
+		}
+		if math.Abs(monthly.Items[0].Value-tt.expected) > 1e-9 {
+			t.Errorf("Expected %v for mode %d, got %v", tt.expected, tt.mode, monthly.Items[0].Value)
+		}
+	}
+}
+
+func TestResample_ShouldNotSeedReducerWithZero(t *testing.T) {
+	losses, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, -5).
+		AddMonth(2024, 1, -3).
+		Build()
+
+	monthly, _ := Resample(&losses, (*Period).SplitByMonths, ResampleLast, func(p Period, a int, b int) int { return max(a, b) })
+	if len(monthly.Items) != 1 || monthly.Items[0].Value != -3 {
+		t.Errorf("Expected -3, got %v", monthly.Items)
+	}
+}
+
+func TestResample_ShouldRejectUnboundedTimeline(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddPeriodValue(NewPeriodValue(Since(DateOnly(2024, 1, 1)), 1)).
+		Build()
+
+	if _, err := Resample(&timeline, (*Period).SplitByMonths, ResampleLast, sum[int]); err == nil {
+		t.Errorf("Expected an error for an unbounded timeline")
+	}
+}
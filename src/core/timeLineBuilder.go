@@ -50,7 +50,12 @@ func (b *TimeLineBuilder[T]) AddPeriodValue(pv PeriodValue[T]) *TimeLineBuilder[
 
 // AddMonth adds a period corresponding to a given month with a value.
 func (b *TimeLineBuilder[T]) AddMonth(year int, month int, value T) *TimeLineBuilder[T] {
-	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	return b.AddMonthIn(year, month, time.UTC, value)
+}
+
+// AddMonthIn adds a period corresponding to a given month in given location with a value.
+func (b *TimeLineBuilder[T]) AddMonthIn(year int, month int, loc *time.Location, value T) *TimeLineBuilder[T] {
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
 	end := start.AddDate(0, 1, 0)
 
 	return b.AddPeriod(start, end, value)
@@ -58,12 +63,83 @@ func (b *TimeLineBuilder[T]) AddMonth(year int, month int, value T) *TimeLineBui
 
 // AddDay adds a period corresponding to a given day with a value.
 func (b *TimeLineBuilder[T]) AddDay(year int, month int, day int, value T) *TimeLineBuilder[T] {
-	start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return b.AddDayIn(year, month, day, time.UTC, value)
+}
+
+// AddDayIn adds a period corresponding to a given day in given location with a value.
+func (b *TimeLineBuilder[T]) AddDayIn(year int, month int, day int, loc *time.Location, value T) *TimeLineBuilder[T] {
+	start := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
 	end := start.AddDate(0, 0, 1)
 
 	return b.AddPeriod(start, end, value)
 }
 
+// AddWeek adds a period corresponding to a given ISO week with a value.
+func (b *TimeLineBuilder[T]) AddWeek(year int, week int, value T) *TimeLineBuilder[T] {
+	return b.AddWeekIn(year, week, time.UTC, value)
+}
+
+// AddWeekIn adds a period corresponding to a given ISO week in given location with a value.
+func (b *TimeLineBuilder[T]) AddWeekIn(year int, week int, loc *time.Location, value T) *TimeLineBuilder[T] {
+	p, err := ISOWeekIn(year, week, loc)
+	return b.addPeriodOrError(p, err, value)
+}
+
+// AddQuarter adds a period corresponding to a given quarter with a value.
+func (b *TimeLineBuilder[T]) AddQuarter(year int, quarter int, value T) *TimeLineBuilder[T] {
+	return b.AddQuarterIn(year, quarter, time.UTC, value)
+}
+
+// AddQuarterIn adds a period corresponding to a given quarter in given location with a value.
+func (b *TimeLineBuilder[T]) AddQuarterIn(year int, quarter int, loc *time.Location, value T) *TimeLineBuilder[T] {
+	p, err := QuarterIn(year, quarter, loc)
+	return b.addPeriodOrError(p, err, value)
+}
+
+// AddYear adds a period corresponding to a given year with a value.
+func (b *TimeLineBuilder[T]) AddYear(year int, value T) *TimeLineBuilder[T] {
+	return b.AddYearIn(year, time.UTC, value)
+}
+
+// AddYearIn adds a period corresponding to a given year in given location with a value.
+func (b *TimeLineBuilder[T]) AddYearIn(year int, loc *time.Location, value T) *TimeLineBuilder[T] {
+	p, err := YearIn(year, loc)
+	return b.addPeriodOrError(p, err, value)
+}
+
+// AddRecurrence adds a period with the same value for each occurrence of a recurrence within given window.
+// The window must have an end unless the rule has a COUNT or an UNTIL.
+func (b *TimeLineBuilder[T]) AddRecurrence(r *Recurrence, window Period, value T) *TimeLineBuilder[T] {
+	if b.err != nil {
+		return b
+	}
+
+	periods, err := r.Periods(window)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	for p := range periods {
+		b.AddPeriodValue(NewPeriodValue(p, value))
+	}
+	return b
+}
+
+// addPeriodOrError adds the result of a Period constructor, keeping its error if any.
+func (b *TimeLineBuilder[T]) addPeriodOrError(p *Period, err error, value T) *TimeLineBuilder[T] {
+	if b.err != nil {
+		return b
+	}
+
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	return b.AddPeriodValue(NewPeriodValue(*p, value))
+}
+
 // Build builds the Timeline by sorting the periods in chronological order.
 func (b *TimeLineBuilder[T]) Build() (Timeline[T], error) {
 	if b.err != nil {
@@ -2,12 +2,19 @@ package core
 
 import (
 	"errors"
+	"iter"
+	"slices"
 	"sort"
+	"time"
 )
 
-// Timeline represents a list of PeriodValue objects
+// Timeline represents a list of PeriodValue objects.
+// Items are expected to be sorted by period start. Queries use an index built by Add, Set, Erase, Update,
+// SortTimelineByPeriodStart and TimeLineBuilder.Build, and scan the items when Items was replaced or
+// grown since. Call Reindex after modifying Items in place, or use Index for a snapshot.
 type Timeline[T any] struct {
 	Items []PeriodValue[T]
+	index *TimelineIndex[T]
 }
 
 // NewTimeline creates and returns an empty Timeline.
@@ -22,9 +29,49 @@ func (t *Timeline[T]) SortTimelineByPeriodStart() {
 	sort.Slice(t.Items, func(i, j int) bool {
 		return t.Items[i].Period.Start.Before(t.Items[j].Period.Start)
 	})
+	t.Reindex()
 }
 
+// Reindex rebuilds the index used by queries. It must be called after Items are modified in place;
+// it drops the index when items are not sorted.
+func (t *Timeline[T]) Reindex() {
+	t.index = nil
+	if t.checkSorted() == nil {
+		t.index = newTimelineIndex(t.Items)
+	}
+}
+
+// currentIndex returns the index of the items, or nil when it was not built on the current items.
+// Queries never build the index, so that they stay read-only and safe for concurrent use.
+func (t *Timeline[T]) currentIndex() *TimelineIndex[T] {
+	if t.index == nil || !t.index.isValidFor(t.Items) {
+		return nil
+	}
+	return t.index
+}
+
+// queryIndex returns the index of the items, building a transient one for callers about to run many
+// queries when the Timeline has none. It returns nil when items are not sorted.
+func (t *Timeline[T]) queryIndex() *TimelineIndex[T] {
+	if index := t.currentIndex(); index != nil {
+		return index
+	}
+	if t.checkSorted() != nil {
+		return nil
+	}
+	return newTimelineIndex(t.Items)
+}
+
+// FindIntersects returns the items intersecting given period, in chronological order.
 func (t *Timeline[T]) FindIntersects(period Period) []PeriodValue[T] {
+	if index := t.currentIndex(); index != nil {
+		return index.FindIntersects(period)
+	}
+	return t.scanIntersects(period)
+}
+
+// scanIntersects finds intersecting items with a linear scan of the items.
+func (t *Timeline[T]) scanIntersects(period Period) []PeriodValue[T] {
 	var items []PeriodValue[T]
 
 	for _, current := range t.Items {
@@ -42,16 +89,77 @@ func (t *Timeline[T]) FindIntersects(period Period) []PeriodValue[T] {
 	return items
 }
 
+// ValueAt returns the value of the item containing given time, for a timeline without overlapping items
+// such as a resolved one. The flag is false when no item contains that time.
+func (t *Timeline[T]) ValueAt(at time.Time) (T, bool) {
+	// the last item starting at or before given time is the only candidate
+	i := sort.Search(len(t.Items), func(i int) bool {
+		return t.Items[i].Period.Start.After(at)
+	})
+	if i > 0 && t.Items[i-1].Period.Contains(at) {
+		return t.Items[i-1].Value, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// ValuesAt returns the values of all items containing given time, in chronological order.
+func (t *Timeline[T]) ValuesAt(at time.Time) []T {
+	if index := t.currentIndex(); index != nil {
+		return index.ValuesAt(at)
+	}
+	return valuesOf(t.scanIntersects(Period{Start: at, End: at.Add(time.Nanosecond)}))
+}
+
+func valuesOf[T any](items []PeriodValue[T]) []T {
+	values := make([]T, len(items))
+	for i, item := range items {
+		values[i] = item.Value
+	}
+	return values
+}
+
+// ValuesAtEach returns the values of the items containing each given time, in the same order as times.
+// Times are sorted and the items swept once, keeping the items started and not yet ended.
+func (t *Timeline[T]) ValuesAtEach(times []time.Time) [][]T {
+	order := make([]int, len(times))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(a, b int) int { return times[a].Compare(times[b]) })
+
+	values := make([][]T, len(times))
+	var active []int
+	next := 0
+	for _, i := range order {
+		at := times[i]
+		for next < len(t.Items) && !t.Items[next].Period.Start.After(at) {
+			active = append(active, next)
+			next++
+		}
+		// items ended before at are dropped, the others stay in chronological order
+		active = slices.DeleteFunc(active, func(j int) bool { return !t.Items[j].Period.End.After(at) })
+
+		values[i] = make([]T, len(active))
+		for k, j := range active {
+			values[i][k] = t.Items[j].Value
+		}
+	}
+	return values
+}
+
 // Add allows adding a new PeriodValue to the Timeline
 func (t *Timeline[T]) Add(newPeriod Period, newValue T) {
-	// Update the Timeline items with the new list
-	t.Items = append(t.Items, PeriodValue[T]{
+	// Insert the item after the ones starting at the same time or before, keeping items sorted
+	position := sort.Search(len(t.Items), func(i int) bool {
+		return t.Items[i].Period.Start.After(newPeriod.Start)
+	})
+	t.Items = slices.Insert(t.Items, position, PeriodValue[T]{
 		Period: newPeriod,
 		Value:  newValue,
 	})
-
-	// Ensure the items are sorted by the Start date after adding
-	t.SortTimelineByPeriodStart()
+	t.Reindex()
 }
 
 // GetAll returns all PeriodValues in the Timeline
@@ -59,67 +167,67 @@ func (t *Timeline[T]) GetAll() []PeriodValue[T] {
 	return t.Items
 }
 
-func computeValuesOnSamePeriods[T any](buffer []PeriodValue[T], f func(p Period, a T, b T) T) []PeriodValue[T] {
-	var items []PeriodValue[T]
-	periods := SplitAllPeriods(buffer)
-
-	for _, period := range periods {
-		var currentValue T
-
-		for _, candidate := range buffer {
-			if candidate.Period.Intersects(period) {
-				currentValue = f(period, candidate.Value, currentValue)
+// All returns an iterator over index and PeriodValue pairs of the Timeline, in chronological order.
+func (t *Timeline[T]) All() iter.Seq2[int, PeriodValue[T]] {
+	return func(yield func(int, PeriodValue[T]) bool) {
+		for i, item := range t.Items {
+			if !yield(i, item) {
+				return
 			}
 		}
+	}
+}
 
-		items = append(items, NewPeriodValue(period, currentValue))
+// Backward returns an iterator over index and PeriodValue pairs of the Timeline, in reverse chronological order.
+func (t *Timeline[T]) Backward() iter.Seq2[int, PeriodValue[T]] {
+	return func(yield func(int, PeriodValue[T]) bool) {
+		for i := len(t.Items) - 1; i >= 0; i-- {
+			if !yield(i, t.Items[i]) {
+				return
+			}
+		}
 	}
+}
 
-	return items
+// Coverage returns the set of periods covered by at least one item of the Timeline.
+func (t *Timeline[T]) Coverage() PeriodSet {
+	periods := make([]Period, len(t.Items))
+	for i, item := range t.Items {
+		periods[i] = item.Period
+	}
+	return NewPeriodSet(periods...)
 }
 
 // ResolveConflicts returns another Timeline having all values with same period aggregated, slicing them if necessary.
+// Values covering each slice are folded in chronological order of their items, starting from the zero value of T.
+// Slices never overlap: an item ending after the ones it overlaps is sliced along all of them, where
+// former versions could return overlapping slices for it.
 func (t *Timeline[T]) ResolveConflicts(f func(p Period, a T, b T) T) (Timeline[T], error) {
-	var items []PeriodValue[T]
-	var buffer []PeriodValue[T]
-	var currentPeriod Period
-
-	for i, next := range t.Items {
-		if i == 0 {
-			currentPeriod = next.Period
-			buffer = append(buffer, next)
-			continue
-		}
+	if err := t.checkSorted(); err != nil {
+		return Timeline[T]{}, err
+	}
 
-		// We assume that periods are chronologically sorted
-		if next.Period.Before(currentPeriod) {
-			return Timeline[T]{}, errors.New("timeline should have sorted periods")
+	var items []PeriodValue[T]
+	sweepSegments(t.Items, func(segment Period, active []int) bool {
+		var currentValue T
+		for _, i := range active {
+			currentValue = f(segment, t.Items[i].Value, currentValue)
 		}
 
-		if next.Period.After(currentPeriod) {
-			computed := computeValuesOnSamePeriods(buffer, f)
-			items = append(items, computed...)
-			currentPeriod = next.Period
-
-			buffer = ClampPeriods(buffer, currentPeriod)
-			buffer = append(buffer, next)
+		items = append(items, NewPeriodValue(segment, currentValue))
+		return true
+	})
 
-			continue
-		}
+	return Timeline[T]{Items: items}, nil
+}
 
-		period, err := NewPeriod(currentPeriod.Start, maxTime(next.Period.Start, currentPeriod.End))
-		if err != nil {
-			return Timeline[T]{}, err
+func (t *Timeline[T]) checkSorted() error {
+	for i := 1; i < len(t.Items); i++ {
+		if t.Items[i].Period.Start.Before(t.Items[i-1].Period.Start) {
+			return errors.New("timeline should have sorted periods")
 		}
-		currentPeriod = *period
-		buffer = append(buffer, next)
 	}
-
-	computed := computeValuesOnSamePeriods(buffer, f)
-	items = append(items, computed...)
-	buffer = make([]PeriodValue[T], 0)
-
-	return Timeline[T]{Items: items}, nil
+	return nil
 }
 
 // Optimize merges all contiguous periods having same value
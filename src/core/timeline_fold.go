@@ -0,0 +1,97 @@
+package core
+
+import (
+	"slices"
+)
+
+// ResolveConflictsFold returns a Timeline slicing t wherever its items overlap. The value of each slice
+// is computed by folding the items covering it, in chronological order: init creates the accumulator
+// of the slice and step adds each item to it. Unlike ResolveConflicts, no zero value of T is folded in.
+func ResolveConflictsFold[T any, A any](t *Timeline[T], init func(p Period) A, step func(acc A, item PeriodValue[T]) A) (Timeline[A], error) {
+	if err := t.checkSorted(); err != nil {
+		return Timeline[A]{}, err
+	}
+
+	var items []PeriodValue[A]
+	sweepSegments(t.Items, func(segment Period, active []int) bool {
+		acc := init(segment)
+		for _, i := range active {
+			acc = step(acc, t.Items[i])
+		}
+
+		items = append(items, NewPeriodValue(segment, acc))
+		return true
+	})
+
+	return Timeline[A]{Items: items}, nil
+}
+
+// ResolveConflictsAll returns a Timeline slicing t wherever its items overlap. The value of each slice
+// is computed by f from all the items covering it, in chronological order and with their whole period.
+func ResolveConflictsAll[T any, A any](t *Timeline[T], f func(p Period, items []PeriodValue[T]) A) (Timeline[A], error) {
+	if err := t.checkSorted(); err != nil {
+		return Timeline[A]{}, err
+	}
+
+	var items []PeriodValue[A]
+	sweepSegments(t.Items, func(segment Period, active []int) bool {
+		covering := make([]PeriodValue[T], len(active))
+		for j, i := range active {
+			covering[j] = t.Items[i]
+		}
+
+		items = append(items, NewPeriodValue(segment, f(segment, covering)))
+		return true
+	})
+
+	return Timeline[A]{Items: items}, nil
+}
+
+// AggregateFold aggregates two timelines like ResolveConflictsFold does with a single one.
+// Items covering a slice are folded in chronological order, items of t first when they start together.
+func AggregateFold[T any, A any](t *Timeline[T], other *Timeline[T], init func(p Period) A, step func(acc A, item PeriodValue[T]) A) (Timeline[A], error) {
+	concat := Timeline[T]{Items: slices.Concat(t.Items, other.Items)}
+	slices.SortStableFunc(concat.Items, func(a, b PeriodValue[T]) int {
+		return a.Period.Start.Compare(b.Period.Start)
+	})
+
+	return ResolveConflictsFold(&concat, init, step)
+}
+
+// AggregateWith aggregates two timelines of possibly different types. The value of each slice covered
+// by any of them is computed by f from the items of each timeline covering it, one of them possibly empty.
+func AggregateWith[T any, U any, A any](t *Timeline[T], other *Timeline[U], f func(p Period, a []PeriodValue[T], b []PeriodValue[U]) A) (Timeline[A], error) {
+	if err := t.checkSorted(); err != nil {
+		return Timeline[A]{}, err
+	}
+	if err := other.checkSorted(); err != nil {
+		return Timeline[A]{}, err
+	}
+
+	// periods of both timelines, those of t first, so that active indexes tell their timeline
+	periods := make([]PeriodValue[struct{}], 0, len(t.Items)+len(other.Items))
+	for _, item := range t.Items {
+		periods = append(periods, NewPeriodValue(item.Period, struct{}{}))
+	}
+	for _, item := range other.Items {
+		periods = append(periods, NewPeriodValue(item.Period, struct{}{}))
+	}
+
+	var items []PeriodValue[A]
+	sweepSegments(periods, func(segment Period, active []int) bool {
+		var a []PeriodValue[T]
+		var b []PeriodValue[U]
+		for _, i := range active {
+			if i < len(t.Items) {
+				a = append(a, t.Items[i])
+			} else {
+				b = append(b, other.Items[i-len(t.Items)])
+			}
+		}
+
+		items = append(items, NewPeriodValue(segment, f(segment, a, b)))
+		return true
+	})
+
+	return Timeline[A]{Items: items}, nil
+}
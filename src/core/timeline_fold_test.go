@@ -0,0 +1,115 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResolveConflictsFold_ShouldNotSeedWithZero(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, -5).
+		AddDay(2024, 1, 10, -3).
+		AddDay(2024, 1, 20, -8).
+		Build()
+
+	result, err := ResolveConflictsFold(&timeline,
+		func(p Period) int { return math.MaxInt },
+		func(acc int, item PeriodValue[int]) int { return min(acc, item.Value) })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 10)}, -5),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 10), End: DateOnly(2024, 1, 11)}, -5),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 11), End: DateOnly(2024, 1, 20)}, -5),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 20), End: DateOnly(2024, 1, 21)}, -8),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 21), End: DateOnly(2024, 2, 1)}, -5),
+	}
+	if !samePeriodValues(result.Items, expected) {
+		t.Errorf("Expected %v, got %v", expected, result.Items)
+	}
+}
+
+func TestResolveConflictsAll_ShouldReceiveEveryOverlappingItem(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[float64]().
+		AddMonth(2024, 1, 100).
+		AddPeriod(DateOnly(2024, 1, 16), DateOnly(2024, 2, 16), 200).
+		Build()
+
+	// mean of the values, and the value of the item started first
+	type summary struct {
+		mean  float64
+		first float64
+	}
+	result, err := ResolveConflictsAll(&timeline, func(p Period, items []PeriodValue[float64]) summary {
+		sum := 0.0
+		for _, item := range items {
+			sum += item.Value
+		}
+		return summary{mean: sum / float64(len(items)), first: items[0].Value}
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []summary{{100, 100}, {150, 100}, {200, 200}}
+	if len(result.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %v", len(expected), result.Items)
+	}
+	for i, e := range expected {
+		if result.Items[i].Value != e {
+			t.Errorf("Expected %v, got %v", e, result.Items[i].Value)
+		}
+	}
+}
+
+func TestAggregateFold(t *testing.T) {
+	a, _ := NewTimeLineBuilder[int]().AddMonth(2024, 1, 3).Build()
+	b, _ := NewTimeLineBuilder[int]().AddMonth(2024, 1, 1).AddMonth(2024, 2, 2).Build()
+
+	result, err := AggregateFold(&a, &b,
+		func(p Period) []int { return nil },
+		func(acc []int, item PeriodValue[int]) []int { return append(acc, item.Value) })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result.Items) != 2 || len(result.Items[0].Value) != 2 || result.Items[0].Value[0] != 3 || result.Items[1].Value[0] != 2 {
+		t.Errorf("Expected [[3 1] [2]], got %v", result.Items)
+	}
+}
+
+func TestAggregateWith_ShouldCombineDifferentTypes(t *testing.T) {
+	budget, _ := NewTimeLineBuilder[float64]().
+		AddQuarter(2024, 1, 30000).
+		Build()
+	headcount, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 2).
+		AddPeriod(DateOnly(2024, 2, 1), DateOnly(2024, 5, 1), 3).
+		Build()
+
+	result, err := AggregateWith(&budget, &headcount, func(p Period, amounts []PeriodValue[float64], heads []PeriodValue[int]) float64 {
+		if len(amounts) == 0 || len(heads) == 0 {
+			return 0
+		}
+		return amounts[0].Value / float64(heads[0].Value)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []PeriodValue[float64]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, 15000.0),
+		NewPeriodValue(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 4, 1)}, 10000.0),
+		NewPeriodValue(Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 5, 1)}, 0.0),
+	}
+	if len(result.Items) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, result.Items)
+	}
+	for i, e := range expected {
+		if !result.Items[i].Period.Equal(e.Period) || result.Items[i].Value != e.Value {
+			t.Errorf("Expected %v, got %v", e, result.Items[i])
+		}
+	}
+}
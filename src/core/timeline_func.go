@@ -0,0 +1,55 @@
+package core
+
+import (
+	"slices"
+)
+
+// Map returns a Timeline with the same periods, whose values are transformed by f.
+func Map[T any, U any](t *Timeline[T], f func(value T) U) Timeline[U] {
+	return MapWithPeriod(t, func(p Period, value T) U {
+		return f(value)
+	})
+}
+
+// MapWithPeriod returns a Timeline with the same periods, whose values are transformed by f from each period and value.
+func MapWithPeriod[T any, U any](t *Timeline[T], f func(p Period, value T) U) Timeline[U] {
+	items := make([]PeriodValue[U], len(t.Items))
+	for i, item := range t.Items {
+		items[i] = NewPeriodValue(item.Period, f(item.Period, item.Value))
+	}
+	return Timeline[U]{Items: items}
+}
+
+// Filter returns a Timeline with the items for which keep returns true.
+func Filter[T any](t *Timeline[T], keep func(item PeriodValue[T]) bool) Timeline[T] {
+	items := []PeriodValue[T]{}
+	for _, item := range t.Items {
+		if keep(item) {
+			items = append(items, item)
+		}
+	}
+	return Timeline[T]{Items: items}
+}
+
+// FlatMap returns a Timeline with the items returned by f for each item, such as one item per month
+// of the item period. Items are sorted by period start.
+func FlatMap[T any, U any](t *Timeline[T], f func(item PeriodValue[T]) []PeriodValue[U]) Timeline[U] {
+	items := []PeriodValue[U]{}
+	for _, item := range t.Items {
+		items = append(items, f(item)...)
+	}
+
+	slices.SortStableFunc(items, func(a, b PeriodValue[U]) int {
+		return a.Period.Start.Compare(b.Period.Start)
+	})
+	return Timeline[U]{Items: items}
+}
+
+// Reduce folds the items of the Timeline in chronological order, starting from init.
+func Reduce[T any, A any](t *Timeline[T], init A, f func(acc A, item PeriodValue[T]) A) A {
+	acc := init
+	for _, item := range t.Items {
+		acc = f(acc, item)
+	}
+	return acc
+}
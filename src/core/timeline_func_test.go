@@ -0,0 +1,83 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+type expense struct {
+	Category string
+	Amount   float64
+}
+
+func newExpenseTimeline() Timeline[expense] {
+	timeline, _ := NewTimeLineBuilder[expense]().
+		AddMonth(2024, 1, expense{"rent", 1200}).
+		AddMonth(2024, 1, expense{"food", 400}).
+		AddQuarter(2024, 2, expense{"insurance", 300}).
+		Build()
+	return timeline
+}
+
+func TestMap(t *testing.T) {
+	timeline := newExpenseTimeline()
+
+	categories := Map(&timeline, func(e expense) string { return e.Category })
+
+	if len(categories.Items) != 3 || categories.Items[2].Value != "insurance" || !categories.Items[2].Period.Equal(timeline.Items[2].Period) {
+		t.Errorf("Expected categories on same periods, got %v", categories.Items)
+	}
+
+	labels := MapWithPeriod(&timeline, func(p Period, e expense) string {
+		return fmt.Sprintf("%s %s", p.Start.Format("2006-01"), e.Category)
+	})
+	if labels.Items[2].Value != "2024-04 insurance" {
+		t.Errorf("Expected 2024-04 insurance, got %v", labels.Items[2].Value)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	timeline := newExpenseTimeline()
+
+	large := Filter(&timeline, func(item PeriodValue[expense]) bool { return item.Value.Amount >= 400 })
+	if len(large.Items) != 2 {
+		t.Errorf("Expected 2 items, got %v", large.Items)
+	}
+
+	none := Filter(&timeline, func(item PeriodValue[expense]) bool { return false })
+	if none.Items == nil || len(none.Items) != 0 {
+		t.Errorf("Expected an empty timeline, got %v", none.Items)
+	}
+}
+
+func TestFlatMap_ShouldSplitItemsByMonth(t *testing.T) {
+	timeline := newExpenseTimeline()
+
+	monthly := FlatMap(&timeline, func(item PeriodValue[expense]) []PeriodValue[float64] {
+		var items []PeriodValue[float64]
+		for month := range item.Period.SplitByMonths() {
+			share := float64(month.Duration()) / float64(item.Period.Duration())
+			items = append(items, NewPeriodValue(month, item.Value.Amount*share))
+		}
+		return items
+	})
+
+	if len(monthly.Items) != 5 {
+		t.Fatalf("Expected 5 items, got %v", monthly.Items)
+	}
+	if monthly.Items[2].Period.Duration() != 30*24*time.Hour || monthly.Items[2].Value != 300*30.0/91 {
+		t.Errorf("Expected april share of insurance, got %v", monthly.Items[2])
+	}
+}
+
+func TestReduce(t *testing.T) {
+	timeline := newExpenseTimeline()
+
+	total := Reduce(&timeline, 0.0, func(acc float64, item PeriodValue[expense]) float64 {
+		return acc + item.Value.Amount
+	})
+	if total != 1900 {
+		t.Errorf("Expected 1900, got %v", total)
+	}
+}
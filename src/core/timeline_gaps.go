@@ -0,0 +1,49 @@
+package core
+
+import (
+	"slices"
+)
+
+// Gaps returns the parts of within not covered by any item of the Timeline.
+func (t *Timeline[T]) Gaps(within Period) PeriodSet {
+	return t.Coverage().Complement(within)
+}
+
+// FillGaps returns another Timeline where the gaps within given period are covered by items having given value.
+func (t *Timeline[T]) FillGaps(within Period, value T) Timeline[T] {
+	return t.FillGapsWith(within, func(gap Period, prev *PeriodValue[T], next *PeriodValue[T]) T {
+		return value
+	})
+}
+
+// FillGapsWith returns another Timeline where the gaps within given period are covered by items whose
+// value is computed by f, for instance to carry the previous value forward or to interpolate.
+// f receives the item ending last before the gap and the item starting first after it, nil when there is none.
+func (t *Timeline[T]) FillGapsWith(within Period, f func(gap Period, prev *PeriodValue[T], next *PeriodValue[T]) T) Timeline[T] {
+	items := slices.Clone(t.Items)
+
+	var prev *PeriodValue[T]
+	i := 0
+	for gap := range t.Gaps(within).All() {
+		// items starting before the gap also end before it, since it is not covered
+		for ; i < len(t.Items) && t.Items[i].Period.Start.Before(gap.End); i++ {
+			if prev == nil || !t.Items[i].Period.End.Before(prev.Period.End) {
+				item := t.Items[i]
+				prev = &item
+			}
+		}
+
+		var next *PeriodValue[T]
+		if i < len(t.Items) {
+			item := t.Items[i]
+			next = &item
+		}
+
+		items = append(items, NewPeriodValue(gap, f(gap, prev, next)))
+	}
+
+	slices.SortStableFunc(items, func(a, b PeriodValue[T]) int {
+		return a.Period.Start.Compare(b.Period.Start)
+	})
+	return Timeline[T]{Items: items}
+}
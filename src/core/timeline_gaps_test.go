@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+)
+
+func newTimelineWithMissingMonths() Timeline[int] {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 2, 200).
+		AddMonth(2024, 3, 300).
+		AddMonth(2024, 6, 600).
+		Build()
+	return timeline
+}
+
+func TestTimeline_Gaps(t *testing.T) {
+	timeline := newTimelineWithMissingMonths()
+	year, _ := Year(2024)
+
+	expected := NewPeriodSet(
+		Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)},
+		Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 6, 1)},
+		Period{Start: DateOnly(2024, 7, 1), End: DateOnly(2025, 1, 1)},
+	)
+	if gaps := timeline.Gaps(*year); !gaps.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, gaps)
+	}
+
+	march, _ := Month(2024, 3)
+	if gaps := timeline.Gaps(*march); !gaps.IsEmpty() {
+		t.Errorf("Expected no gap in march, got %v", gaps)
+	}
+}
+
+func TestTimeline_FillGaps(t *testing.T) {
+	timeline := newTimelineWithMissingMonths()
+	semester := Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 7, 1)}
+
+	filled := timeline.FillGaps(semester, 0)
+
+	assertItems(t, filled, []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, 0),
+		NewPeriodValue(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, 200),
+		NewPeriodValue(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 1)}, 300),
+		NewPeriodValue(Period{Start: DateOnly(2024, 4, 1), End: DateOnly(2024, 6, 1)}, 0),
+		NewPeriodValue(Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2024, 7, 1)}, 600),
+	})
+
+	if len(timeline.Items) != 3 {
+		t.Errorf("Expected original timeline to be unchanged, got %v", timeline.Items)
+	}
+}
+
+func TestTimeline_FillGapsWith(t *testing.T) {
+	timeline := newTimelineWithMissingMonths()
+	semester := Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 7, 1)}
+
+	// carry the previous value forward, or use the next one before the first item
+	carried := timeline.FillGapsWith(semester, func(gap Period, prev *PeriodValue[int], next *PeriodValue[int]) int {
+		if prev == nil {
+			return next.Value
+		}
+		return prev.Value
+	})
+	if carried.Items[0].Value != 200 || carried.Items[3].Value != 300 {
+		t.Errorf("Expected values to be carried, got %v", carried.Items)
+	}
+
+	// interpolate between neighbours
+	interpolated := timeline.FillGapsWith(semester, func(gap Period, prev *PeriodValue[int], next *PeriodValue[int]) int {
+		if prev == nil || next == nil {
+			return -1
+		}
+		return (prev.Value + next.Value) / 2
+	})
+	if interpolated.Items[0].Value != -1 || interpolated.Items[3].Value != 450 {
+		t.Errorf("Expected interpolated values, got %v", interpolated.Items)
+	}
+}
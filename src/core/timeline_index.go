@@ -0,0 +1,92 @@
+package core
+
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
+// TimelineIndex answers intersection and point queries over a Timeline in O(log n + k).
+// The index returned by Index is a snapshot: it keeps its own copy of the items, so that later
+// changes to the Timeline never make it stale, and it is safe for concurrent use.
+type TimelineIndex[T any] struct {
+	items []PeriodValue[T]
+	// maxEnd is a segment tree over the items order, each node keeping the maximum end of its items,
+	// so that subtrees ending before the query are skipped.
+	maxEnd []time.Time
+}
+
+// Index returns an index of the current items of the Timeline, which must be sorted by start.
+func (t *Timeline[T]) Index() (*TimelineIndex[T], error) {
+	if err := t.checkSorted(); err != nil {
+		return nil, err
+	}
+
+	return newTimelineIndex(slices.Clone(t.Items)), nil
+}
+
+// newTimelineIndex indexes given items, which must be sorted by start, without copying them.
+func newTimelineIndex[T any](items []PeriodValue[T]) *TimelineIndex[T] {
+	index := &TimelineIndex[T]{items: items, maxEnd: make([]time.Time, 4*len(items))}
+	if len(items) > 0 {
+		index.build(1, 0, len(items))
+	}
+	return index
+}
+
+// isValidFor checks if the index was built on given items, as long as they were not modified in place.
+func (x *TimelineIndex[T]) isValidFor(items []PeriodValue[T]) bool {
+	if len(x.items) != len(items) {
+		return false
+	}
+	return len(items) == 0 || &x.items[0] == &items[0]
+}
+
+func (x *TimelineIndex[T]) build(node int, lo int, hi int) time.Time {
+	if hi-lo == 1 {
+		x.maxEnd[node] = x.items[lo].Period.End
+		return x.maxEnd[node]
+	}
+
+	mid := (lo + hi) / 2
+	x.maxEnd[node] = maxTime(x.build(2*node, lo, mid), x.build(2*node+1, mid, hi))
+	return x.maxEnd[node]
+}
+
+// FindIntersects returns the items intersecting given period, in chronological order.
+func (x *TimelineIndex[T]) FindIntersects(period Period) []PeriodValue[T] {
+	return x.intersecting(period.Start, period.End)
+}
+
+// ValuesAt returns the values of all items containing given time, in chronological order.
+func (x *TimelineIndex[T]) ValuesAt(at time.Time) []T {
+	return valuesOf(x.intersecting(at, at.Add(time.Nanosecond)))
+}
+
+// intersecting returns items starting before end and ending after start, in chronological order.
+func (x *TimelineIndex[T]) intersecting(start time.Time, end time.Time) []PeriodValue[T] {
+	// only items starting before end may intersect
+	count := sort.Search(len(x.items), func(i int) bool {
+		return !x.items[i].Period.Start.Before(end)
+	})
+
+	var found []PeriodValue[T]
+	if count > 0 {
+		found = x.collect(found, 1, 0, len(x.items), count, start)
+	}
+	return found
+}
+
+func (x *TimelineIndex[T]) collect(found []PeriodValue[T], node int, lo int, hi int, count int, start time.Time) []PeriodValue[T] {
+	if lo >= count || !x.maxEnd[node].After(start) {
+		return found
+	}
+
+	if hi-lo == 1 {
+		return append(found, x.items[lo])
+	}
+
+	mid := (lo + hi) / 2
+	found = x.collect(found, 2*node, lo, mid, count, start)
+	return x.collect(found, 2*node+1, mid, hi, count, start)
+}
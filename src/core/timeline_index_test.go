@@ -0,0 +1,165 @@
+package core
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// randomTimeline returns a sorted timeline of n items, mixing short and very long periods.
+func randomTimeline(rng *rand.Rand, n int) Timeline[int] {
+	builder := NewTimeLineBuilder[int]()
+	origin := DateOnly(2000, 1, 1)
+
+	for i := 0; i < n; i++ {
+		start := origin.Add(time.Duration(rng.Intn(n*24)) * time.Hour)
+		length := time.Duration(1+rng.Intn(72)) * time.Hour
+		if rng.Intn(50) == 0 {
+			length *= 1000
+		}
+		builder.AddPeriod(start, start.Add(length), i)
+	}
+
+	timeline, _ := builder.Build()
+	return timeline
+}
+
+func samePeriodValues(a []PeriodValue[int], b []PeriodValue[int]) bool {
+	return slices.EqualFunc(a, b, func(x PeriodValue[int], y PeriodValue[int]) bool {
+		return x.Value == y.Value && x.Period.Equal(y.Period)
+	})
+}
+
+func TestTimelineIndex_FindIntersects_ShouldMatchLinearScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for round := 0; round < 20; round++ {
+		timeline := randomTimeline(rng, 1+rng.Intn(500))
+		index, err := timeline.Index()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		last := timeline.Items[len(timeline.Items)-1].Period.Start
+
+		for q := 0; q < 50; q++ {
+			start := DateOnly(2000, 1, 1).Add(time.Duration(rng.Int63n(int64(last.Sub(DateOnly(2000, 1, 1)) + 1))))
+			period := Period{Start: start, End: start.Add(time.Duration(1+rng.Intn(100)) * time.Hour)}
+
+			expected := timeline.scanIntersects(period)
+			if found := index.FindIntersects(period); !samePeriodValues(found, expected) {
+				t.Fatalf("Expected %v, got %v", expected, found)
+			}
+			if found := timeline.FindIntersects(period); !samePeriodValues(found, expected) {
+				t.Fatalf("Expected %v through the timeline, got %v", expected, found)
+			}
+			if values := index.ValuesAt(start); !slices.Equal(values, timeline.ValuesAt(start)) {
+				t.Fatalf("Expected %v, got %v", timeline.ValuesAt(start), values)
+			}
+		}
+	}
+}
+
+func TestTimelineIndex_FindIntersects_ShouldFindLongItemsStartedEarlier(t *testing.T) {
+	timeline := NewTimeline[string]()
+	timeline.Add(Period{Start: DateOnly(2020, 1, 1), End: DateOnly(2030, 1, 1)}, "contract")
+	for day := 1; day <= 28; day++ {
+		timeline.Add(Period{Start: DateOnly(2024, 2, day), End: DateOnly(2024, 2, day+1)}, "daily")
+	}
+
+	index, _ := timeline.Index()
+	found := index.FindIntersects(Period{Start: DateOnly(2024, 6, 1), End: DateOnly(2024, 7, 1)})
+	if len(found) != 1 || found[0].Value != "contract" {
+		t.Errorf("Expected only the contract, got %v", found)
+	}
+}
+
+func TestTimelineIndex_ShouldNotSeeLaterChanges(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		AddMonth(2024, 5, 2).
+		Build()
+	index, _ := timeline.Index()
+
+	timeline.Items[0].Period.End = DateOnly(2024, 4, 1)
+	slices.Reverse(timeline.Items)
+
+	if found := index.FindIntersects(Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 2, 11)}); len(found) != 0 {
+		t.Errorf("Expected the index to keep january only, got %v", found)
+	}
+	if values := index.ValuesAt(DateOnly(2024, 5, 15)); !slices.Equal(values, []int{2}) {
+		t.Errorf("Expected [2], got %v", values)
+	}
+
+	if _, err := timeline.Index(); err == nil {
+		t.Errorf("Expected an error for unsorted items")
+	}
+}
+
+func TestTimeline_FindIntersects_ShouldScanWhenIndexIsStale(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 1).
+		Build()
+	if timeline.currentIndex() == nil {
+		t.Fatalf("Expected Build to index the timeline")
+	}
+
+	timeline.Items = append(timeline.Items, NewPeriodValue(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, 2))
+	if timeline.currentIndex() != nil {
+		t.Errorf("Expected the index not to be used after a direct append")
+	}
+	if found := timeline.FindIntersects(Always()); len(found) != 2 {
+		t.Errorf("Expected 2 items after a direct append, got %v", found)
+	}
+
+	timeline.Items[1].Period.End = DateOnly(2024, 2, 10)
+	timeline.Reindex()
+	if values := timeline.ValuesAt(DateOnly(2024, 2, 15)); len(values) != 0 {
+		t.Errorf("Expected no value after reindex, got %v", values)
+	}
+
+	timeline.Add(Period{Start: DateOnly(2024, 2, 15), End: DateOnly(2024, 2, 16)}, 3)
+	if timeline.currentIndex() == nil {
+		t.Errorf("Expected Add to index the timeline")
+	}
+}
+
+func benchmarkTimeline(b *testing.B) (Timeline[int], []Period) {
+	rng := rand.New(rand.NewSource(1))
+	timeline := randomTimeline(rng, 200_000)
+
+	queries := make([]Period, 1024)
+	for i := range queries {
+		start := DateOnly(2000, 1, 1).Add(time.Duration(rng.Intn(200_000*24)) * time.Hour)
+		queries[i] = Period{Start: start, End: start.Add(24 * time.Hour)}
+	}
+
+	b.ResetTimer()
+	return timeline, queries
+}
+
+func BenchmarkTimelineIndex_FindIntersects(b *testing.B) {
+	timeline, queries := benchmarkTimeline(b)
+	index, _ := timeline.Index()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		index.FindIntersects(queries[i%len(queries)])
+	}
+}
+
+func BenchmarkTimeline_FindIntersects(b *testing.B) {
+	timeline, queries := benchmarkTimeline(b)
+
+	for i := 0; i < b.N; i++ {
+		timeline.FindIntersects(queries[i%len(queries)])
+	}
+}
+
+func BenchmarkTimeline_ScanIntersects(b *testing.B) {
+	timeline, queries := benchmarkTimeline(b)
+
+	for i := 0; i < b.N; i++ {
+		timeline.scanIntersects(queries[i%len(queries)])
+	}
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"slices"
+	"sort"
+)
+
+// Set, Erase and Update give the Timeline interval map semantics: they expect items to be sorted and
+// not to overlap, as after ResolveConflicts, and keep them so.
+
+// Set sets the value of given period, trimming or cutting the items it overlaps.
+func (t *Timeline[T]) Set(period Period, value T) {
+	if period.IsEmpty() {
+		return
+	}
+
+	lo, hi := t.overlappedRange(period)
+	replacement := t.remainders(lo, hi, period, NewPeriodValue(period, value))
+	t.Items = slices.Replace(t.Items, lo, hi, replacement...)
+	t.Reindex()
+}
+
+// Erase removes given period from the timeline, trimming or cutting the items it overlaps.
+func (t *Timeline[T]) Erase(period Period) {
+	if period.IsEmpty() {
+		return
+	}
+
+	lo, hi := t.overlappedRange(period)
+	replacement := t.remainders(lo, hi, period)
+	t.Items = slices.Replace(t.Items, lo, hi, replacement...)
+	t.Reindex()
+}
+
+// Update transforms the values within given period. Items overlapping the period are cut at its bounds,
+// and f receives the period of each part within it. Periods without items are left empty.
+func (t *Timeline[T]) Update(period Period, f func(p Period, value T) T) {
+	if period.IsEmpty() {
+		return
+	}
+
+	lo, hi := t.overlappedRange(period)
+	updated := make([]PeriodValue[T], 0, hi-lo)
+	for _, item := range t.Items[lo:hi] {
+		part := Period{Start: maxTime(item.Period.Start, period.Start), End: minTime(item.Period.End, period.End)}
+		updated = append(updated, NewPeriodValue(part, f(part, item.Value)))
+	}
+
+	replacement := t.remainders(lo, hi, period, updated...)
+	t.Items = slices.Replace(t.Items, lo, hi, replacement...)
+	t.Reindex()
+}
+
+// overlappedRange returns the range of items intersecting given period.
+func (t *Timeline[T]) overlappedRange(period Period) (int, int) {
+	lo := sort.Search(len(t.Items), func(i int) bool {
+		return t.Items[i].Period.End.After(period.Start)
+	})
+	hi := sort.Search(len(t.Items), func(i int) bool {
+		return !t.Items[i].Period.Start.Before(period.End)
+	})
+	return lo, max(lo, hi)
+}
+
+// remainders returns the parts of the items in given range outside the period, around the inner items.
+func (t *Timeline[T]) remainders(lo int, hi int, period Period, inner ...PeriodValue[T]) []PeriodValue[T] {
+	if lo == hi {
+		return inner
+	}
+
+	var items []PeriodValue[T]
+	if first := t.Items[lo]; first.Period.Start.Before(period.Start) {
+		items = append(items, NewPeriodValue(Period{Start: first.Period.Start, End: period.Start}, first.Value))
+	}
+	items = append(items, inner...)
+	if last := t.Items[hi-1]; last.Period.End.After(period.End) {
+		items = append(items, NewPeriodValue(Period{Start: period.End, End: last.Period.End}, last.Value))
+	}
+	return items
+}
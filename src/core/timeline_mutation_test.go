@@ -0,0 +1,88 @@
+package core
+
+import (
+	"testing"
+)
+
+func newQuarterTimeline() Timeline[int] {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 2, 200).
+		AddMonth(2024, 3, 300).
+		Build()
+	return timeline
+}
+
+func assertItems(t *testing.T, timeline Timeline[int], expected []PeriodValue[int]) {
+	t.Helper()
+
+	if !samePeriodValues(timeline.Items, expected) {
+		t.Errorf("Expected %v, got %v", expected, timeline.Items)
+	}
+}
+
+func TestTimeline_Set(t *testing.T) {
+	timeline := newQuarterTimeline()
+
+	timeline.Set(Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 3, 10)}, 0)
+
+	assertItems(t, timeline, []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 15)}, 100),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 15), End: DateOnly(2024, 3, 10)}, 0),
+		NewPeriodValue(Period{Start: DateOnly(2024, 3, 10), End: DateOnly(2024, 4, 1)}, 300),
+	})
+
+	// setting within a single item cuts it in two
+	timeline.Set(Period{Start: DateOnly(2024, 1, 5), End: DateOnly(2024, 1, 6)}, 1)
+	if len(timeline.Items) != 5 || timeline.Items[1].Value != 1 || !timeline.Items[2].Period.Start.Equal(DateOnly(2024, 1, 6)) {
+		t.Errorf("Expected the first item to be cut, got %v", timeline.Items)
+	}
+
+	// setting outside any item inserts it in order
+	timeline.Set(Period{Start: DateOnly(2023, 12, 1), End: DateOnly(2023, 12, 2)}, -1)
+	if timeline.Items[0].Value != -1 {
+		t.Errorf("Expected the new item first, got %v", timeline.Items)
+	}
+
+	if value, _ := timeline.ValueAt(DateOnly(2024, 2, 20)); value != 0 {
+		t.Errorf("Expected 0 after set, got %v", value)
+	}
+}
+
+func TestTimeline_Erase(t *testing.T) {
+	timeline := newQuarterTimeline()
+
+	timeline.Erase(Period{Start: DateOnly(2024, 2, 10), End: DateOnly(2024, 2, 20)})
+	assertItems(t, timeline, []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, 100),
+		NewPeriodValue(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 10)}, 200),
+		NewPeriodValue(Period{Start: DateOnly(2024, 2, 20), End: DateOnly(2024, 3, 1)}, 200),
+		NewPeriodValue(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 1)}, 300),
+	})
+
+	timeline.Erase(Until(DateOnly(2024, 3, 15)))
+	assertItems(t, timeline, []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 3, 15), End: DateOnly(2024, 4, 1)}, 300),
+	})
+
+	timeline.Erase(Period{Start: DateOnly(2025, 1, 1), End: DateOnly(2025, 2, 1)})
+	if len(timeline.Items) != 1 {
+		t.Errorf("Expected erasing elsewhere to change nothing, got %v", timeline.Items)
+	}
+}
+
+func TestTimeline_Update(t *testing.T) {
+	timeline := newQuarterTimeline()
+	timeline.Erase(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 2, 15)})
+
+	timeline.Update(Period{Start: DateOnly(2024, 1, 16), End: DateOnly(2024, 3, 1)}, func(p Period, value int) int {
+		return value * 2
+	})
+
+	assertItems(t, timeline, []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 16)}, 100),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 16), End: DateOnly(2024, 2, 1)}, 200),
+		NewPeriodValue(Period{Start: DateOnly(2024, 2, 15), End: DateOnly(2024, 3, 1)}, 400),
+		NewPeriodValue(Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 4, 1)}, 300),
+	})
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"slices"
+	"time"
+)
+
+type sweepEventKind int
+
+const (
+	// startEvent makes an item active.
+	startEvent sweepEventKind = iota
+	// endEvent makes an item inactive.
+	endEvent
+	// markEvent is an empty item, which only cuts segments.
+	markEvent
+)
+
+type sweepEvent struct {
+	at   time.Time
+	item int
+	kind sweepEventKind
+}
+
+// sweepSegments cuts the periods covered by items into segments at every item start and end, and calls
+// yield for each segment with the indexes of the items covering it, in ascending order.
+// Segments are yielded in chronological order; periods covered by no item are skipped.
+// The active slice is reused between calls and must not be retained.
+//
+// Events are sorted once and the active set is kept sorted, so cutting costs O(n log n) plus the size
+// of the active set on each segment. An instant met in several locations is represented by its first
+// occurrence, as SplitAllPeriods does.
+func sweepSegments[T any](items []PeriodValue[T], yield func(segment Period, active []int) bool) {
+	events := make([]sweepEvent, 0, 2*len(items))
+	for i, item := range items {
+		if item.Period.IsEmpty() {
+			events = append(events, sweepEvent{at: item.Period.Start, item: i, kind: markEvent})
+			continue
+		}
+		events = append(events,
+			sweepEvent{at: item.Period.Start, item: i, kind: startEvent},
+			sweepEvent{at: item.Period.End, item: i, kind: endEvent})
+	}
+	slices.SortStableFunc(events, func(a, b sweepEvent) int { return a.at.Compare(b.at) })
+
+	var active []int
+	var segmentStart time.Time
+
+	for len(events) > 0 {
+		n := 1
+		for n < len(events) && events[n].at.Equal(events[0].at) {
+			n++
+		}
+		group := events[:n]
+		events = events[n:]
+
+		if len(active) > 0 {
+			if !yield(Period{Start: segmentStart, End: group[0].at}, active) {
+				return
+			}
+		}
+
+		for _, e := range group {
+			if e.kind == endEvent {
+				i, _ := slices.BinarySearch(active, e.item)
+				active = slices.Delete(active, i, i+1)
+			}
+		}
+
+		// when every item ended, the next segment starts with the items starting now
+		segmentStart = group[0].at
+		if len(active) == 0 {
+			for _, e := range group {
+				if e.kind == startEvent {
+					segmentStart = e.at
+					break
+				}
+			}
+		}
+
+		for _, e := range group {
+			if e.kind == startEvent {
+				i, _ := slices.BinarySearch(active, e.item)
+				active = slices.Insert(active, i, e.item)
+			}
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package core
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// resolveConflictsByBuffer is the former implementation of ResolveConflicts, kept verbatim as a reference:
+// it buffers overlapping items and tests every item against every slice of the buffer. It extends the
+// buffer up to the latest start only, so it returns overlapping slices when an item of the buffer ends
+// after the first one and another item starts in between.
+func resolveConflictsByBuffer[T any](t *Timeline[T], f func(p Period, a T, b T) T) (Timeline[T], error) {
+	var items []PeriodValue[T]
+	var buffer []PeriodValue[T]
+	var currentPeriod Period
+
+	for i, next := range t.Items {
+		if i == 0 {
+			currentPeriod = next.Period
+			buffer = append(buffer, next)
+			continue
+		}
+
+		// We assume that periods are chronologically sorted
+		if next.Period.Before(currentPeriod) {
+			return Timeline[T]{}, errors.New("timeline should have sorted periods")
+		}
+
+		if next.Period.After(currentPeriod) {
+			computed := computeValuesOnSamePeriods(buffer, f)
+			items = append(items, computed...)
+			currentPeriod = next.Period
+
+			buffer = ClampPeriods(buffer, currentPeriod)
+			buffer = append(buffer, next)
+
+			continue
+		}
+
+		period, err := NewPeriod(currentPeriod.Start, maxTime(next.Period.Start, currentPeriod.End))
+		if err != nil {
+			return Timeline[T]{}, err
+		}
+		currentPeriod = *period
+		buffer = append(buffer, next)
+	}
+
+	computed := computeValuesOnSamePeriods(buffer, f)
+	items = append(items, computed...)
+	buffer = make([]PeriodValue[T], 0)
+
+	return Timeline[T]{Items: items}, nil
+}
+
+func computeValuesOnSamePeriods[T any](buffer []PeriodValue[T], f func(p Period, a T, b T) T) []PeriodValue[T] {
+	var items []PeriodValue[T]
+	periods := SplitAllPeriods(buffer)
+
+	for _, period := range periods {
+		var currentValue T
+
+		for _, candidate := range buffer {
+			if candidate.Period.Intersects(period) {
+				currentValue = f(period, candidate.Value, currentValue)
+			}
+		}
+
+		items = append(items, NewPeriodValue(period, currentValue))
+	}
+
+	return items
+}
+
+// orderSensitiveSum folds values in a way that depends on their order and on the period.
+func orderSensitiveSum(p Period, a int, b int) int {
+	return b*7 + a + int(p.Duration().Hours())
+}
+
+// resolveConflictsByBruteForce slices all the items on all their bounds, and folds every item intersecting
+// each slice, skipping slices covered by no item.
+func resolveConflictsByBruteForce[T any](t *Timeline[T], f func(p Period, a T, b T) T) []PeriodValue[T] {
+	var items []PeriodValue[T]
+	for _, slice := range SplitAllPeriods(t.Items) {
+		var value T
+		covered := false
+		for _, item := range t.Items {
+			if item.Period.Intersects(slice) {
+				value = f(slice, item.Value, value)
+				covered = true
+			}
+		}
+		if covered {
+			items = append(items, NewPeriodValue(slice, value))
+		}
+	}
+	return items
+}
+
+// randomDayTimeline returns a timeline of n items on a coarse grid of days, so that many bounds coincide,
+// with long items outliving the ones they overlap.
+func randomDayTimeline(rng *rand.Rand, n int, days int) Timeline[int] {
+	timeline := NewTimeline[int]()
+	for i := 0; i < n; i++ {
+		start := DateOnly(2024, 1, 1).AddDate(0, 0, rng.Intn(days))
+		length := 1 + rng.Intn(days/3)
+		timeline.Items = append(timeline.Items, NewPeriodValue(Period{Start: start, End: start.AddDate(0, 0, length)}, i+1))
+	}
+	slices.SortStableFunc(timeline.Items, func(a, b PeriodValue[int]) int { return a.Period.Start.Compare(b.Period.Start) })
+	return timeline
+}
+
+// randomClusteredTimeline returns a timeline on a coarse grid of days, so that many bounds coincide.
+// Items are grouped in clusters where no item ends after the first one, the inputs on which the
+// buffered implementation is correct.
+func randomClusteredTimeline(rng *rand.Rand, clusters int) Timeline[int] {
+	timeline := NewTimeline[int]()
+	start := DateOnly(2024, 1, 1)
+	value := 0
+
+	for c := 0; c < clusters; c++ {
+		start = start.AddDate(0, 0, rng.Intn(3))
+		length := 1 + rng.Intn(20)
+		end := start.AddDate(0, 0, length)
+
+		value++
+		cluster := []PeriodValue[int]{NewPeriodValue(Period{Start: start, End: end}, value)}
+		for i := rng.Intn(6); i > 0; i-- {
+			from := rng.Intn(length)
+			to := from + 1 + rng.Intn(length-from)
+			value++
+			cluster = append(cluster, NewPeriodValue(Period{Start: start.AddDate(0, 0, from), End: start.AddDate(0, 0, to)}, value))
+		}
+		slices.SortStableFunc(cluster[1:], func(a, b PeriodValue[int]) int { return a.Period.Start.Compare(b.Period.Start) })
+
+		timeline.Items = append(timeline.Items, cluster...)
+		start = end
+	}
+	return timeline
+}
+
+func TestTimeline_ResolveConflicts_ShouldMatchBufferedImplementation(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for round := 0; round < 500; round++ {
+		timeline := randomClusteredTimeline(rng, rng.Intn(8))
+
+		expected, err := resolveConflictsByBuffer(&timeline, orderSensitiveSum)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		result, err := timeline.ResolveConflicts(orderSensitiveSum)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !samePeriodValues(result.Items, expected.Items) {
+			t.Fatalf("Expected %v, got %v for %v", expected.Items, result.Items, timeline.Items)
+		}
+	}
+}
+
+func TestTimeline_ResolveConflicts_ShouldMatchBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+
+	for round := 0; round < 500; round++ {
+		timeline := randomDayTimeline(rng, rng.Intn(30), 10+rng.Intn(60))
+
+		result, err := timeline.ResolveConflicts(orderSensitiveSum)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if expected := resolveConflictsByBruteForce(&timeline, orderSensitiveSum); !samePeriodValues(result.Items, expected) {
+			t.Fatalf("Expected %v, got %v for %v", expected, result.Items, timeline.Items)
+		}
+	}
+}
+
+func TestTimeline_ResolveConflicts_ShouldNotOverlapAfterLongItem(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddPeriod(DateOnly(2024, 1, 1), DateOnly(2024, 1, 3), 1).
+		AddPeriod(DateOnly(2024, 1, 2), DateOnly(2024, 1, 10), 10).
+		AddPeriod(DateOnly(2024, 1, 5), DateOnly(2024, 1, 6), 100).
+		Build()
+	add := func(p Period, a int, b int) int { return a + b }
+
+	// the buffered implementation closed the buffer on the 3rd, and sliced the long item twice
+	former, _ := resolveConflictsByBuffer(&timeline, add)
+	if !former.Items[2].Period.End.Equal(DateOnly(2024, 1, 10)) || !former.Items[3].Period.Start.Equal(DateOnly(2024, 1, 5)) {
+		t.Fatalf("Expected overlapping slices from the buffered implementation, got %v", former.Items)
+	}
+
+	result, _ := timeline.ResolveConflicts(add)
+
+	expected := []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 1, 2)}, 1),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 2), End: DateOnly(2024, 1, 3)}, 11),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 3), End: DateOnly(2024, 1, 5)}, 10),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 5), End: DateOnly(2024, 1, 6)}, 110),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 6), End: DateOnly(2024, 1, 10)}, 10),
+	}
+	if !samePeriodValues(result.Items, expected) {
+		t.Errorf("Expected %v, got %v", expected, result.Items)
+	}
+}
+
+func TestTimeline_ResolveConflicts_ShouldRejectUnsortedItems(t *testing.T) {
+	timeline := Timeline[int]{Items: []PeriodValue[int]{
+		NewPeriodValue(Period{Start: DateOnly(2024, 2, 1), End: DateOnly(2024, 3, 1)}, 1),
+		NewPeriodValue(Period{Start: DateOnly(2024, 1, 1), End: DateOnly(2024, 2, 1)}, 2),
+	}}
+
+	if _, err := timeline.ResolveConflicts(orderSensitiveSum); err == nil {
+		t.Errorf("Expected an error for unsorted items")
+	}
+}
+
+// deepTimeline returns n items all overlapping each other, each starting one hour after the previous one.
+func deepTimeline(n int) Timeline[int] {
+	builder := NewTimeLineBuilder[int]()
+	for i := 0; i < n; i++ {
+		start := DateOnly(2024, 1, 1).Add(time.Duration(i) * time.Hour)
+		builder.AddPeriod(start, start.Add(time.Duration(n)*time.Hour), 1)
+	}
+	timeline, _ := builder.Build()
+	return timeline
+}
+
+func BenchmarkTimeline_ResolveConflicts_DeepOverlap(b *testing.B) {
+	timeline := deepTimeline(2000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		timeline.ResolveConflicts(func(p Period, a int, b int) int { return a + b })
+	}
+}
+
+func BenchmarkTimeline_ResolveConflictsByBuffer_DeepOverlap(b *testing.B) {
+	timeline := deepTimeline(2000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		resolveConflictsByBuffer(&timeline, func(p Period, a int, b int) int { return a + b })
+	}
+}
@@ -2,6 +2,8 @@ package core
 
 import (
 	"fmt"
+	"math/rand"
+	"slices"
 	"testing"
 	"time"
 )
@@ -731,3 +733,152 @@ func TestTimeline_Aggregate_ShouldReturnAdditions(t *testing.T) {
 		}
 	}
 }
+
+func TestTimeline_AllAndBackward(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 2, 200).
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 3, 300).
+		Build()
+
+	var forward []int
+	for i, pv := range timeline.All() {
+		if timeline.Items[i].Value != pv.Value {
+			t.Errorf("Expected index %d to hold %v, got %v", i, timeline.Items[i].Value, pv.Value)
+		}
+		forward = append(forward, pv.Value)
+	}
+
+	var backward []int
+	for _, pv := range timeline.Backward() {
+		backward = append(backward, pv.Value)
+		if len(backward) == 2 {
+			break
+		}
+	}
+
+	if !slices.Equal(forward, []int{100, 200, 300}) {
+		t.Errorf("Expected forward values to be [100 200 300], got %v", forward)
+	}
+
+	if !slices.Equal(backward, []int{300, 200}) {
+		t.Errorf("Expected backward values to be [300 200], got %v", backward)
+	}
+}
+
+func TestTimeLineBuilder_AddMonthIn_ShouldUseLocation(t *testing.T) {
+	paris := loadParis(t)
+
+	timeline, err := NewTimeLineBuilder[int]().
+		AddMonthIn(2024, 1, paris, 100).
+		AddDayIn(2024, 3, 31, paris, 10).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	january, _ := MonthIn(2024, 1, paris)
+	if !timeline.Items[0].Period.Equal(*january) {
+		t.Errorf("Expected period to be %v, got %v", *january, timeline.Items[0].Period)
+	}
+
+	if timeline.Items[1].Period.Duration() != 23*time.Hour {
+		t.Errorf("Expected DST day to last 23h, got %v", timeline.Items[1].Period.Duration())
+	}
+}
+
+func TestTimeline_ResolveConflicts_ShouldHandleOpenEndedPeriods(t *testing.T) {
+	timeline, err := NewTimeLineBuilder[int]().
+		AddPeriodValue(NewPeriodValue(Since(DateOnly(2024, 3, 1)), 100)).
+		AddMonth(2024, 5, 50).
+		Build()
+	if err != nil {
+		t.Fatalf("Could not create timeline: %s", err)
+	}
+
+	result, err := timeline.ResolveConflicts(func(p Period, a int, b int) int { return a + b })
+	if err != nil {
+		t.Fatalf("Could not resolve conflicts: %s", err)
+	}
+
+	expected := []PeriodValue[int]{
+		{Period: Period{Start: DateOnly(2024, 3, 1), End: DateOnly(2024, 5, 1)}, Value: 100},
+		{Period: Period{Start: DateOnly(2024, 5, 1), End: DateOnly(2024, 6, 1)}, Value: 150},
+		{Period: Since(DateOnly(2024, 6, 1)), Value: 100},
+	}
+
+	if len(result.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(result.Items))
+	}
+
+	for i, e := range expected {
+		if !result.Items[i].Period.Equal(e.Period) || result.Items[i].Value != e.Value {
+			t.Errorf("Expected %v, got %v", e, result.Items[i])
+		}
+	}
+}
+
+func TestTimeline_ValueAt(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddMonth(2024, 1, 100).
+		AddMonth(2024, 3, 300).
+		Build()
+
+	tests := []struct {
+		at       time.Time
+		expected int
+		found    bool
+	}{
+		{DateOnly(2023, 12, 31), 0, false},
+		{DateOnly(2024, 1, 1), 100, true},
+		{DateOnly(2024, 1, 31), 100, true},
+		{DateOnly(2024, 2, 15), 0, false},
+		{DateOnly(2024, 3, 15), 300, true},
+		{DateOnly(2024, 4, 1), 0, false},
+	}
+
+	for _, tt := range tests {
+		value, found := timeline.ValueAt(tt.at)
+		if value != tt.expected || found != tt.found {
+			t.Errorf("Expected (%v, %v) on %v, got (%v, %v)", tt.expected, tt.found, tt.at, value, found)
+		}
+	}
+}
+
+func TestTimeline_ValuesAt(t *testing.T) {
+	timeline, _ := NewTimeLineBuilder[int]().
+		AddYear(2024, 1200).
+		AddMonth(2024, 3, 300).
+		AddDay(2024, 3, 15, 10).
+		Build()
+
+	if values := timeline.ValuesAt(DateOnly(2024, 3, 15)); !slices.Equal(values, []int{1200, 300, 10}) {
+		t.Errorf("Expected [1200 300 10], got %v", values)
+	}
+
+	if values := timeline.ValuesAt(DateOnly(2025, 1, 1)); len(values) != 0 {
+		t.Errorf("Expected no value, got %v", values)
+	}
+
+	values := timeline.ValuesAtEach([]time.Time{DateOnly(2024, 4, 1), DateOnly(2024, 3, 16), DateOnly(2023, 1, 1)})
+	expected := [][]int{{1200}, {1200, 300}, {}}
+	if !slices.EqualFunc(values, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+}
+
+func TestTimeline_ValuesAtEach_ShouldMatchLinearScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	timeline := randomTimeline(rng, 300)
+
+	times := make([]time.Time, 100)
+	for i := range times {
+		times[i] = DateOnly(2000, 1, 1).Add(time.Duration(rng.Intn(300*24)) * time.Hour)
+	}
+
+	for i, values := range timeline.ValuesAtEach(times) {
+		if expected := valuesOf(timeline.scanIntersects(Period{Start: times[i], End: times[i].Add(time.Nanosecond)})); !slices.Equal(values, expected) {
+			t.Fatalf("Expected %v at %v, got %v", expected, times[i], values)
+		}
+	}
+}
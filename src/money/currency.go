@@ -0,0 +1,48 @@
+package money
+
+import (
+	"fmt"
+)
+
+// Currency is an ISO 4217 currency code, such as EUR.
+type Currency string
+
+const (
+	EUR Currency = "EUR"
+	USD Currency = "USD"
+	CHF Currency = "CHF"
+	GBP Currency = "GBP"
+	JPY Currency = "JPY"
+)
+
+// minorUnits gives the number of decimals of each known currency.
+var minorUnits = map[Currency]int{
+	"AUD": 2, "BHD": 3, "CAD": 2, "CHF": 2, "CNY": 2, "CZK": 2, "DKK": 2, "EUR": 2, "GBP": 2,
+	"HKD": 2, "HUF": 2, "ISK": 0, "JPY": 0, "KRW": 0, "KWD": 3, "MAD": 2, "NOK": 2, "NZD": 2,
+	"PLN": 2, "SEK": 2, "SGD": 2, "TND": 3, "USD": 2, "XAF": 0, "XOF": 0,
+}
+
+// ParseCurrency returns the Currency of given ISO 4217 code.
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(code)
+	if !c.IsValid() {
+		return "", fmt.Errorf("unknown currency %q", code)
+	}
+	return c, nil
+}
+
+// IsValid checks if the currency is a known ISO 4217 currency.
+func (c Currency) IsValid() bool {
+	_, found := minorUnits[c]
+	return found
+}
+
+// Digits returns the number of decimals of the currency minor unit, such as 2 for cents.
+func (c Currency) Digits() int {
+	return minorUnits[c]
+}
+
+// String returns the ISO 4217 code of the currency.
+func (c Currency) String() string {
+	return string(c)
+}
@@ -0,0 +1,210 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"time"
+
+	"src/core"
+)
+
+// Pair is a currency pair. Its rate is the amount of Quote currency for one unit of Base currency.
+type Pair struct {
+	Base  Currency
+	Quote Currency
+}
+
+// String formats the pair as BASE/QUOTE, such as EUR/USD.
+func (p Pair) String() string {
+	return p.Base.String() + "/" + p.Quote.String()
+}
+
+// Fixing is the rate published on a day.
+type Fixing struct {
+	Date core.Date
+	Rate float64
+}
+
+// Rates holds a timeline of exchange rates per currency pair. Pairs missing for a conversion are
+// derived from the inverse pair, or triangulated through the base currency.
+type Rates struct {
+	base  Currency
+	loc   *time.Location
+	pairs map[Pair]*core.Timeline[*big.Rat]
+}
+
+// NewRates creates Rates triangulating through given base currency, with fixing dates in given location.
+func NewRates(base Currency, loc *time.Location) *Rates {
+	return &Rates{base: base, loc: loc, pairs: map[Pair]*core.Timeline[*big.Rat]{}}
+}
+
+// SetRate sets the rate of the pair during given period, overriding rates previously set on it.
+func (r *Rates) SetRate(pair Pair, period core.Period, rate float64) error {
+	exact, err := exactRate(rate)
+	if err != nil {
+		return fmt.Errorf("invalid rate for %v: %w", pair, err)
+	}
+
+	timeline, found := r.pairs[pair]
+	if !found {
+		created := core.NewTimeline[*big.Rat]()
+		timeline = &created
+		r.pairs[pair] = timeline
+	}
+	timeline.Set(period, exact)
+	return nil
+}
+
+// SetFixings sets the rates of the pair from daily fixings. Each fixing holds until the next one,
+// such as a friday fixing during the weekend, and the last one holds for its day.
+func (r *Rates) SetFixings(pair Pair, fixings []Fixing) error {
+	sorted := slices.SortedFunc(slices.Values(fixings), func(a, b Fixing) int { return a.Date.Compare(b.Date) })
+
+	for i, fixing := range sorted {
+		end := fixing.Date.AddDays(1)
+		if i+1 < len(sorted) {
+			end = sorted[i+1].Date
+		}
+
+		period := core.Period{Start: fixing.Date.In(r.loc), End: end.In(r.loc)}
+		if err := r.SetRate(pair, period, fixing.Rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exactRate returns the decimal value of the shortest representation of rate, so that 1.0853 is exactly 10853/10000.
+func exactRate(rate float64) (*big.Rat, error) {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) || rate <= 0 {
+		return nil, fmt.Errorf("rate %v must be positive", rate)
+	}
+	exact, _ := new(big.Rat).SetString(strconv.FormatFloat(rate, 'g', -1, 64))
+	return exact, nil
+}
+
+// Timeline returns the rates from a currency to another one: direct rates first, then inverse
+// rates, then rates triangulated through the base currency where both legs are known.
+func (r *Rates) Timeline(from Currency, to Currency) (core.Timeline[*big.Rat], error) {
+	if from == to {
+		return core.Timeline[*big.Rat]{Items: []core.PeriodValue[*big.Rat]{core.NewPeriodValue(core.Always(), big.NewRat(1, 1))}}, nil
+	}
+
+	layers := core.NewLayeredTimeline[*big.Rat]()
+	if from != r.base && to != r.base {
+		first := r.known(from, r.base)
+		second := r.known(r.base, to)
+		triangulated, err := core.AggregateWith(&first, &second, func(p core.Period, a []core.PeriodValue[*big.Rat], b []core.PeriodValue[*big.Rat]) *big.Rat {
+			if len(a) == 0 || len(b) == 0 {
+				return nil
+			}
+			return new(big.Rat).Mul(a[0].Value, b[0].Value)
+		})
+		if err != nil {
+			return core.Timeline[*big.Rat]{}, err
+		}
+		layers.AddLayer("triangulated", 0, core.Filter(&triangulated, func(item core.PeriodValue[*big.Rat]) bool {
+			return item.Value != nil
+		}))
+	}
+	layers.AddLayer("known", 1, r.known(from, to))
+
+	flat, err := layers.Flatten()
+	if err != nil {
+		return core.Timeline[*big.Rat]{}, err
+	}
+	return core.Map(&flat, func(v core.LayerValue[*big.Rat]) *big.Rat { return v.Value }), nil
+}
+
+// known returns the direct rates of the pair, completed by the inverse of the rates of the inverse pair.
+func (r *Rates) known(from Currency, to Currency) core.Timeline[*big.Rat] {
+	layers := core.NewLayeredTimeline[*big.Rat]()
+	if inverse, found := r.pairs[Pair{Base: to, Quote: from}]; found {
+		layers.AddLayer("inverse", 0, core.Map(inverse, func(rate *big.Rat) *big.Rat { return new(big.Rat).Inv(rate) }))
+	}
+	if direct, found := r.pairs[Pair{Base: from, Quote: to}]; found {
+		layers.AddLayer("direct", 1, *direct)
+	}
+
+	flat, _ := layers.Flatten()
+	return core.Map(&flat, func(v core.LayerValue[*big.Rat]) *big.Rat { return v.Value })
+}
+
+// MissingRateError reports the periods of a conversion without a known rate.
+type MissingRateError struct {
+	Pair    Pair
+	Periods core.PeriodSet
+}
+
+func (e *MissingRateError) Error() string {
+	return fmt.Sprintf("missing %v rate for %v", e.Pair, e.Periods)
+}
+
+// Convert returns a Timeline of the amounts converted into given currency, rounded with given mode.
+// Items are cut wherever the rate changes, each part keeping the whole amount converted at its rate.
+// Periods without rate are reported by a *MissingRateError for the first pair missing some.
+func (r *Rates) Convert(t *core.Timeline[Money], to Currency, mode RoundingMode) (core.Timeline[Money], error) {
+	rates := map[Currency]*core.Timeline[*big.Rat]{}
+	var items []core.PeriodValue[Money]
+
+	for _, item := range t.Items {
+		from := item.Value.Currency()
+		if from == "" {
+			items = append(items, core.NewPeriodValue(item.Period, FromMinor(0, to)))
+			continue
+		}
+
+		timeline, found := rates[from]
+		if !found {
+			computed, err := r.Timeline(from, to)
+			if err != nil {
+				return core.Timeline[Money]{}, err
+			}
+			// the rates are queried once per item
+			computed.Reindex()
+			timeline = &computed
+			rates[from] = timeline
+		}
+
+		if missing := timeline.Gaps(item.Period); !missing.IsEmpty() {
+			return core.Timeline[Money]{}, r.missingRates(t, from, to, timeline)
+		}
+
+		for _, rate := range timeline.FindIntersects(item.Period) {
+			part, _ := item.Period.Clamp(rate.Period)
+			converted, err := item.Value.Convert(rate.Value, to, mode)
+			if err != nil {
+				return core.Timeline[Money]{}, err
+			}
+			items = append(items, core.NewPeriodValue(part, converted))
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b core.PeriodValue[Money]) int { return a.Period.Start.Compare(b.Period.Start) })
+	return core.Timeline[Money]{Items: items}, nil
+}
+
+// missingRates returns the error reporting all the periods of the items in given currency without rate.
+func (r *Rates) missingRates(t *core.Timeline[Money], from Currency, to Currency, rates *core.Timeline[*big.Rat]) error {
+	missing := core.NewPeriodSet()
+	for _, item := range t.Items {
+		if item.Value.Currency() == from {
+			missing = missing.Union(rates.Gaps(item.Period))
+		}
+	}
+	return &MissingRateError{Pair: Pair{Base: from, Quote: to}, Periods: missing}
+}
+
+// Convert returns the amount converted into given currency at given rate, rounded with given mode.
+func (m Money) Convert(rate *big.Rat, to Currency, mode RoundingMode) (Money, error) {
+	factor := new(big.Rat).SetFrac(pow10(to.Digits()), pow10(m.currency.Digits()))
+	converted, err := m.Mul(factor.Mul(factor, rate), mode)
+	if err != nil {
+		return Money{}, err
+	}
+	converted.currency = to
+	return converted, nil
+}
@@ -0,0 +1,110 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"src/core"
+)
+
+func newRates(t *testing.T) *Rates {
+	rates := NewRates(EUR, time.UTC)
+
+	january, _ := core.Month(2024, 1)
+	if err := rates.SetRate(Pair{EUR, USD}, *january, 1.09); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// fixings on thursday and friday, the friday rate holding during the weekend
+	err := rates.SetFixings(Pair{EUR, CHF}, []Fixing{
+		{Date: core.NewDate(2024, time.January, 5), Rate: 0.93},
+		{Date: core.NewDate(2024, time.January, 4), Rate: 0.94},
+		{Date: core.NewDate(2024, time.January, 8), Rate: 0.92},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return rates
+}
+
+func TestMoney_Convert(t *testing.T) {
+	rate, _ := exactRate(1.0853)
+	if converted, _ := MustParse("100.00 EUR").Convert(rate, USD, HalfEven); converted != MustParse("108.53 USD") {
+		t.Errorf("Expected 108.53 USD, got %v", converted)
+	}
+
+	if converted, _ := MustParse("100.00 EUR").Convert(big.NewRat(16125, 100), JPY, HalfEven); converted != MustParse("16125 JPY") {
+		t.Errorf("Expected 16125 JPY, got %v", converted)
+	}
+}
+
+func TestRates_Timeline(t *testing.T) {
+	rates := newRates(t)
+	weekend := core.DateOnly(2024, 1, 6)
+
+	inverse, _ := rates.Timeline(CHF, EUR)
+	if rate, found := inverse.ValueAt(weekend); !found || rate.Cmp(big.NewRat(100, 93)) != 0 {
+		t.Errorf("Expected 100/93 during the weekend, got %v", rate)
+	}
+
+	triangulated, _ := rates.Timeline(USD, CHF)
+	if rate, found := triangulated.ValueAt(weekend); !found || rate.Cmp(big.NewRat(93, 109)) != 0 {
+		t.Errorf("Expected 93/109 during the weekend, got %v", rate)
+	}
+
+	if _, found := triangulated.ValueAt(core.DateOnly(2024, 1, 3)); found {
+		t.Errorf("Expected no rate before the first fixing")
+	}
+}
+
+func TestRates_Convert_ShouldSplitWhereRateChanges(t *testing.T) {
+	rates := newRates(t)
+
+	budget, _ := core.NewTimeLineBuilder[Money]().
+		AddPeriod(core.DateOnly(2024, 1, 4), core.DateOnly(2024, 1, 9), MustParse("1000.00 CHF")).
+		AddPeriod(core.DateOnly(2024, 1, 4), core.DateOnly(2024, 1, 5), MustParse("10.00 EUR")).
+		Build()
+
+	converted, err := rates.Convert(&budget, EUR, HalfEven)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []core.PeriodValue[Money]{
+		core.NewPeriodValue(core.Period{Start: core.DateOnly(2024, 1, 4), End: core.DateOnly(2024, 1, 5)}, MustParse("1063.83 EUR")),
+		core.NewPeriodValue(core.Period{Start: core.DateOnly(2024, 1, 4), End: core.DateOnly(2024, 1, 5)}, MustParse("10.00 EUR")),
+		core.NewPeriodValue(core.Period{Start: core.DateOnly(2024, 1, 5), End: core.DateOnly(2024, 1, 8)}, MustParse("1075.27 EUR")),
+		core.NewPeriodValue(core.Period{Start: core.DateOnly(2024, 1, 8), End: core.DateOnly(2024, 1, 9)}, MustParse("1086.96 EUR")),
+	}
+	if len(converted.Items) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, converted.Items)
+	}
+	for i, e := range expected {
+		if !converted.Items[i].Period.Equal(e.Period) || converted.Items[i].Value != e.Value {
+			t.Errorf("Expected %v, got %v", e, converted.Items[i])
+		}
+	}
+}
+
+func TestRates_Convert_ShouldReportMissingRates(t *testing.T) {
+	rates := newRates(t)
+
+	budget, _ := core.NewTimeLineBuilder[Money]().
+		AddMonth(2024, 1, MustParse("100.00 USD")).
+		AddMonth(2024, 2, MustParse("100.00 USD")).
+		Build()
+
+	_, err := rates.Convert(&budget, EUR, HalfEven)
+
+	var missing *MissingRateError
+	if !errors.As(err, &missing) {
+		t.Fatalf("Expected a MissingRateError, got %v", err)
+	}
+
+	february, _ := core.Month(2024, 2)
+	if missing.Pair != (Pair{USD, EUR}) || !missing.Periods.Equal(core.NewPeriodSet(*february)) {
+		t.Errorf("Expected february to miss USD/EUR, got %v", missing)
+	}
+}
@@ -0,0 +1,269 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"src/core"
+)
+
+// Money is an amount in a currency, stored as an integer number of minor units (cents for EUR) so that
+// sums are exact. The zero value has no currency and is neutral for Add and the Reducer methods.
+// Operations overflowing the int64 minor units return ErrOverflow.
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+// ErrOverflow is returned by operations whose result does not fit in int64 minor units.
+var ErrOverflow = errors.New("amount overflows int64 minor units")
+
+// FromMinor returns the Money of given number of minor units of currency, such as cents.
+func FromMinor(units int64, currency Currency) Money {
+	return Money{amount: units, currency: currency}
+}
+
+// Parse parses an amount followed by a currency code, such as "1000.50 EUR", or "0" for the zero value.
+// The amount must not have more decimals than the currency.
+func Parse(s string) (Money, error) {
+	if strings.TrimSpace(s) == "0" {
+		return Money{}, nil
+	}
+
+	amountText, code, found := strings.Cut(strings.TrimSpace(s), " ")
+	if !found {
+		return Money{}, fmt.Errorf("invalid money %q: expected an amount and a currency", s)
+	}
+
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid money %q: %w", s, err)
+	}
+
+	amount, err := parseAmount(amountText, currency.Digits())
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid money %q: %w", s, err)
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// MustParse is like Parse but panics if the text is invalid.
+func MustParse(s string) Money {
+	m, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func parseAmount(s string, digits int) (int64, error) {
+	integer, fraction, _ := strings.Cut(s, ".")
+	if len(fraction) > digits {
+		return 0, fmt.Errorf("more than %d decimals", digits)
+	}
+	if integer == "" || integer == "-" || strings.HasPrefix(fraction, "-") || strings.HasPrefix(fraction, "+") {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	return strconv.ParseInt(integer+fraction+strings.Repeat("0", digits-len(fraction)), 10, 64)
+}
+
+// Minor returns the amount in minor units of its currency.
+func (m Money) Minor() int64 {
+	return m.amount
+}
+
+// Currency returns the currency of the amount.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// IsZero checks if the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// Sign returns -1, 0 or 1 when the amount is negative, zero or positive.
+func (m Money) Sign() int {
+	switch {
+	case m.amount < 0:
+		return -1
+	case m.amount > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Rat returns the amount in major units, such as euros, as an exact rational.
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(m.currency.Digits()))
+}
+
+// checkCurrency returns the currency shared by both amounts, the zero value taking the currency of the other one.
+func (m Money) checkCurrency(other Money) (Currency, error) {
+	switch {
+	case m.currency == "":
+		return other.currency, nil
+	case other.currency == "" || m.currency == other.currency:
+		return m.currency, nil
+	default:
+		return "", fmt.Errorf("currency mismatch: %s and %s", m.currency, other.currency)
+	}
+}
+
+// Add returns the sum of both amounts, which must have the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.checkCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: sum, currency: currency}, nil
+}
+
+// Sub returns the difference of both amounts, which must have the same currency.
+func (m Money) Sub(other Money) (Money, error) {
+	currency, err := m.checkCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	difference := m.amount - other.amount
+	if (other.amount > 0 && difference > m.amount) || (other.amount < 0 && difference < m.amount) {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: difference, currency: currency}, nil
+}
+
+// Negate returns the opposite amount.
+func (m Money) Negate() Money {
+	return Money{amount: -m.amount, currency: m.currency}
+}
+
+// Abs returns the absolute amount.
+func (m Money) Abs() Money {
+	if m.amount < 0 {
+		return m.Negate()
+	}
+	return m
+}
+
+// Mul returns the amount multiplied by factor, rounded to the minor unit with given mode.
+func (m Money) Mul(factor *big.Rat, mode RoundingMode) (Money, error) {
+	product := round(new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), factor), mode)
+	if !product.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: product.Int64(), currency: m.currency}, nil
+}
+
+// Div returns the amount divided by n, rounded to the minor unit with given mode.
+// Use Allocate to split an amount without losing minor units.
+func (m Money) Div(n int64, mode RoundingMode) (Money, error) {
+	if n == 0 {
+		return Money{}, errors.New("division by zero")
+	}
+	return m.Mul(new(big.Rat).SetFrac64(1, n), mode)
+}
+
+// Allocate splits the amount into parts proportional to weights, which sum to the amount exactly.
+func (m Money) Allocate(weights []float64) ([]Money, error) {
+	units, err := core.Allocate(m.amount, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]Money, len(units))
+	for i, u := range units {
+		parts[i] = Money{amount: u, currency: m.currency}
+	}
+	return parts, nil
+}
+
+// Compare returns -1, 0 or 1 when m is less than, equal to or greater than other.
+// Both amounts must have the same currency.
+func (m Money) Compare(other Money) (int, error) {
+	if _, err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// String formats the amount with the decimals of its currency, followed by the currency code, such as "1000.50 EUR".
+// The zero value is formatted as "0".
+func (m Money) String() string {
+	if m.currency == "" {
+		return m.Rat().FloatString(0)
+	}
+	return m.Rat().FloatString(m.currency.Digits()) + " " + m.currency.String()
+}
+
+// checkEncodable refuses amounts without currency other than the zero value, which Parse could not read back.
+func (m Money) checkEncodable() error {
+	if m.currency == "" && m.amount != 0 {
+		return fmt.Errorf("cannot encode amount %d without currency", m.amount)
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, in the format of String.
+func (m Money) MarshalText() ([]byte, error) {
+	if err := m.checkEncodable(); err != nil {
+		return nil, err
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, in the format of Parse.
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+type jsonMoney struct {
+	Amount   string   `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+// MarshalJSON implements json.Marshaler as an object with the amount as a decimal string, so that no
+// precision is lost by JSON numbers: {"amount":"1000.50","currency":"EUR"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if err := m.checkEncodable(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonMoney{Amount: m.Rat().FloatString(m.currency.Digits()), Currency: m.currency})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, in the format of MarshalJSON.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var decoded jsonMoney
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	return m.UnmarshalText([]byte(decoded.Amount + " " + decoded.Currency.String()))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,157 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"src/core"
+)
+
+func TestParse(t *testing.T) {
+	tests := map[string]Money{
+		"1000.50 EUR": FromMinor(100050, EUR),
+		"1000.5 EUR":  FromMinor(100050, EUR),
+		"-0.05 USD":   FromMinor(-5, USD),
+		"1200 JPY":    FromMinor(1200, JPY),
+		"1.234 KWD":   FromMinor(1234, "KWD"),
+	}
+
+	for text, expected := range tests {
+		m, err := Parse(text)
+		if err != nil || m != expected {
+			t.Errorf("Expected %q to be %v, got %v (%v)", text, expected, m, err)
+		}
+	}
+
+	for _, text := range []string{"", "1000.50", "1000.505 EUR", "10.5 JPY", "1.0 XXX", "abc EUR", ". EUR", "1.-5 EUR"} {
+		if _, err := Parse(text); err == nil {
+			t.Errorf("Expected an error for %q", text)
+		}
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	tests := map[Money]string{
+		FromMinor(100050, EUR): "1000.50 EUR",
+		FromMinor(-5, USD):     "-0.05 USD",
+		FromMinor(1200, JPY):   "1200 JPY",
+	}
+
+	for m, expected := range tests {
+		if m.String() != expected {
+			t.Errorf("Expected %v, got %v", expected, m.String())
+		}
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := MustParse("10.25 EUR")
+	b := MustParse("0.80 EUR")
+
+	if sum, err := a.Add(b); err != nil || sum != MustParse("11.05 EUR") {
+		t.Errorf("Expected 11.05 EUR, got %v (%v)", sum, err)
+	}
+
+	if diff, err := b.Sub(a); err != nil || diff != MustParse("-9.45 EUR") {
+		t.Errorf("Expected -9.45 EUR, got %v (%v)", diff, err)
+	}
+
+	if sum, err := (Money{}).Add(a); err != nil || sum != a {
+		t.Errorf("Expected zero value to be neutral, got %v (%v)", sum, err)
+	}
+
+	if _, err := a.Add(MustParse("1.00 USD")); err == nil {
+		t.Errorf("Expected an error when adding different currencies")
+	}
+
+	if third, err := MustParse("100.00 EUR").Div(3, HalfEven); err != nil || third != MustParse("33.33 EUR") {
+		t.Errorf("Expected 33.33 EUR, got %v (%v)", third, err)
+	}
+
+	if _, err := MustParse("100.00 EUR").Div(0, HalfEven); err == nil {
+		t.Errorf("Expected an error when dividing by zero")
+	}
+
+	if vat, err := MustParse("19.99 EUR").Mul(big.NewRat(20, 100), HalfUp); err != nil || vat != MustParse("4.00 EUR") {
+		t.Errorf("Expected 4.00 EUR, got %v (%v)", vat, err)
+	}
+
+	parts, err := MustParse("100.00 EUR").Allocate([]float64{1, 1, 1})
+	if err != nil || parts[0] != MustParse("33.34 EUR") || parts[2] != MustParse("33.33 EUR") {
+		t.Errorf("Expected [33.34 33.33 33.33], got %v (%v)", parts, err)
+	}
+}
+
+func TestMoney_ShouldReportOverflow(t *testing.T) {
+	largest := FromMinor(math.MaxInt64, EUR)
+	smallest := FromMinor(math.MinInt64, EUR)
+	cent := FromMinor(1, EUR)
+
+	if _, err := largest.Add(cent); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected an overflow adding to the largest amount, got %v", err)
+	}
+	if _, err := smallest.Sub(cent); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected an overflow subtracting from the smallest amount, got %v", err)
+	}
+	if _, err := cent.Sub(smallest); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected an overflow subtracting the smallest amount, got %v", err)
+	}
+	if _, err := largest.Mul(big.NewRat(2, 1), HalfEven); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected an overflow doubling the largest amount, got %v", err)
+	}
+
+	if difference, err := FromMinor(-1, EUR).Sub(smallest); err != nil || difference != largest {
+		t.Errorf("Expected the largest amount, got %v (%v)", difference, err)
+	}
+
+	var r Reducer
+	r.Sum(core.Always(), largest, cent)
+	if !errors.Is(r.Err(), ErrOverflow) {
+		t.Errorf("Expected the reducer to keep the overflow, got %v", r.Err())
+	}
+}
+
+func TestMoney_Compare(t *testing.T) {
+	if c, err := MustParse("1.00 EUR").Compare(MustParse("2.00 EUR")); err != nil || c != -1 {
+		t.Errorf("Expected -1, got %v (%v)", c, err)
+	}
+
+	if _, err := MustParse("1.00 EUR").Compare(MustParse("1.00 CHF")); err == nil {
+		t.Errorf("Expected an error when comparing different currencies")
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	type budget struct {
+		Amount Money `json:"amount"`
+	}
+
+	data, err := json.Marshal(budget{Amount: MustParse("1000.50 EUR")})
+	expected := `{"amount":{"amount":"1000.50","currency":"EUR"}}`
+	if err != nil || string(data) != expected {
+		t.Errorf("Expected %s, got %s (%v)", expected, data, err)
+	}
+
+	var decoded budget
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Amount != MustParse("1000.50 EUR") {
+		t.Errorf("Expected 1000.50 EUR, got %v (%v)", decoded.Amount, err)
+	}
+
+	var zero budget
+	data, _ = json.Marshal(zero)
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != zero {
+		t.Errorf("Expected the zero value to round trip through %s, got %v (%v)", data, decoded, err)
+	}
+	if _, err := json.Marshal(budget{Amount: FromMinor(5, "")}); err == nil {
+		t.Errorf("Expected an error encoding an amount without currency")
+	}
+
+	text, _ := MustParse("-3.10 CHF").MarshalText()
+	var m Money
+	if err := m.UnmarshalText(text); err != nil || m != MustParse("-3.10 CHF") {
+		t.Errorf("Expected -3.10 CHF, got %v (%v)", m, err)
+	}
+}
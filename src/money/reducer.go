@@ -0,0 +1,66 @@
+package money
+
+import (
+	"src/core"
+)
+
+// Reducer provides reducers for ResolveConflicts and Aggregate on a core.Timeline[Money].
+// Reducers cannot fail, so the first error, such as a currency mismatch or an overflow, is kept
+// and returned by Err, and the result must then be discarded.
+//
+//	var r money.Reducer
+//	resolved, err := timeline.ResolveConflicts(r.Sum)
+//	if err == nil {
+//		err = r.Err()
+//	}
+type Reducer struct {
+	err error
+}
+
+// Err returns the first error met by the reducer.
+func (r *Reducer) Err() error {
+	return r.err
+}
+
+// Sum adds amounts.
+func (r *Reducer) Sum(p core.Period, a Money, b Money) Money {
+	sum, err := a.Add(b)
+	if err != nil {
+		r.fail(err)
+		return a
+	}
+	return sum
+}
+
+// Min keeps the lowest amount.
+func (r *Reducer) Min(p core.Period, a Money, b Money) Money {
+	return r.keep(a, b, -1)
+}
+
+// Max keeps the highest amount.
+func (r *Reducer) Max(p core.Period, a Money, b Money) Money {
+	return r.keep(a, b, 1)
+}
+
+// keep returns a if it compares to b as given sign. The zero value b, seed of ResolveConflicts, is ignored.
+func (r *Reducer) keep(a Money, b Money, sign int) Money {
+	if b == (Money{}) {
+		return a
+	}
+
+	c, err := a.Compare(b)
+	if err != nil {
+		r.fail(err)
+		return a
+	}
+	if c == sign {
+		return a
+	}
+	return b
+}
+
+func (r *Reducer) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
@@ -0,0 +1,56 @@
+package money
+
+import (
+	"testing"
+
+	"src/core"
+)
+
+func TestReducer_Sum(t *testing.T) {
+	timeline, _ := core.NewTimeLineBuilder[Money]().
+		AddMonth(2024, 1, MustParse("0.10 EUR")).
+		AddMonth(2024, 1, MustParse("0.20 EUR")).
+		AddMonth(2024, 2, MustParse("0.30 EUR")).
+		Build()
+
+	var r Reducer
+	resolved, err := timeline.ResolveConflicts(r.Sum)
+	if err != nil || r.Err() != nil {
+		t.Fatalf("Unexpected error: %v, %v", err, r.Err())
+	}
+
+	if resolved.Items[0].Value != MustParse("0.30 EUR") || resolved.Items[1].Value != MustParse("0.30 EUR") {
+		t.Errorf("Expected exact sums, got %v", resolved.Items)
+	}
+}
+
+func TestReducer_MinMax(t *testing.T) {
+	timeline, _ := core.NewTimeLineBuilder[Money]().
+		AddMonth(2024, 1, MustParse("-5.00 EUR")).
+		AddMonth(2024, 1, MustParse("-3.00 EUR")).
+		Build()
+
+	var r Reducer
+	lowest, _ := timeline.ResolveConflicts(r.Min)
+	highest, _ := timeline.ResolveConflicts(r.Max)
+
+	if r.Err() != nil {
+		t.Fatalf("Unexpected error: %v", r.Err())
+	}
+	if lowest.Items[0].Value != MustParse("-5.00 EUR") || highest.Items[0].Value != MustParse("-3.00 EUR") {
+		t.Errorf("Expected -5.00 EUR and -3.00 EUR, got %v and %v", lowest.Items[0].Value, highest.Items[0].Value)
+	}
+}
+
+func TestReducer_ShouldRefuseMixedCurrencies(t *testing.T) {
+	a, _ := core.NewTimeLineBuilder[Money]().AddMonth(2024, 1, MustParse("10.00 EUR")).Build()
+	b, _ := core.NewTimeLineBuilder[Money]().AddMonth(2024, 1, MustParse("10.00 USD")).Build()
+
+	var r Reducer
+	if _, err := a.Aggregate(&b, r.Sum); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if r.Err() == nil {
+		t.Errorf("Expected a currency mismatch error")
+	}
+}
@@ -0,0 +1,74 @@
+package money
+
+import (
+	"math/big"
+)
+
+// RoundingMode tells how amounts are rounded to the minor unit of their currency.
+type RoundingMode int
+
+const (
+	// HalfEven rounds to the nearest unit, and ties to the even one (banker's rounding).
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest unit, and ties away from zero.
+	HalfUp
+	// HalfDown rounds to the nearest unit, and ties towards zero.
+	HalfDown
+	// Down rounds towards zero.
+	Down
+	// Up rounds away from zero.
+	Up
+	// Floor rounds towards negative infinity.
+	Floor
+	// Ceiling rounds towards positive infinity.
+	Ceiling
+)
+
+// round rounds r to an integer with given mode.
+func round(r *big.Rat, mode RoundingMode) *big.Int {
+	// truncated quotient and remainder, both having the sign of r
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	sign := int64(r.Sign())
+	awayFromZero := func() *big.Int { return quotient.Add(quotient, big.NewInt(sign)) }
+
+	// compare twice the remainder with the denominator to find ties
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	tie := half.Cmp(r.Denom())
+
+	switch mode {
+	case Down:
+		return quotient
+	case Up:
+		return awayFromZero()
+	case Floor:
+		if sign < 0 {
+			return awayFromZero()
+		}
+		return quotient
+	case Ceiling:
+		if sign > 0 {
+			return awayFromZero()
+		}
+		return quotient
+	case HalfUp:
+		if tie >= 0 {
+			return awayFromZero()
+		}
+		return quotient
+	case HalfDown:
+		if tie > 0 {
+			return awayFromZero()
+		}
+		return quotient
+	default:
+		if tie > 0 || (tie == 0 && quotient.Bit(0) == 1) {
+			return awayFromZero()
+		}
+		return quotient
+	}
+}
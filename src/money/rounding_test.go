@@ -0,0 +1,31 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	values := []*big.Rat{big.NewRat(25, 10), big.NewRat(35, 10), big.NewRat(-25, 10), big.NewRat(26, 10), big.NewRat(-24, 10)}
+
+	tests := []struct {
+		mode     RoundingMode
+		expected []int64
+	}{
+		{HalfEven, []int64{2, 4, -2, 3, -2}},
+		{HalfUp, []int64{3, 4, -3, 3, -2}},
+		{HalfDown, []int64{2, 3, -2, 3, -2}},
+		{Down, []int64{2, 3, -2, 2, -2}},
+		{Up, []int64{3, 4, -3, 3, -3}},
+		{Floor, []int64{2, 3, -3, 2, -3}},
+		{Ceiling, []int64{3, 4, -2, 3, -2}},
+	}
+
+	for _, tt := range tests {
+		for i, value := range values {
+			if rounded := round(value, tt.mode).Int64(); rounded != tt.expected[i] {
+				t.Errorf("Expected %v rounded with mode %d to be %d, got %d", value, tt.mode, tt.expected[i], rounded)
+			}
+		}
+	}
+}